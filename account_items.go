@@ -2,7 +2,9 @@ package freee
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"path"
 	"reflect"
 
 	"github.com/google/go-querystring/query"
@@ -11,6 +13,10 @@ import (
 
 const (
 	APIPathAccountItems = "account_items"
+
+	// 勘定科目の検索可能設定
+	AccountItemSearchableEnabled  = 2
+	AccountItemSearchableDisabled = 3
 )
 
 type GetAccountItemsOpts struct {
@@ -21,6 +27,10 @@ type AccountItems struct {
 	AccountItems []AccountItem `json:"account_items"`
 }
 
+type AccountItemResponse struct {
+	AccountItem AccountItemDetail `json:"account_item"`
+}
+
 type AccountItem struct {
 	// 勘定科目ID
 	ID int32 `json:"id"`
@@ -57,6 +67,68 @@ type AccountItem struct {
 	CorrespondingExpenseID *int32 `json:"corresponding_expense_id,omitempty"`
 }
 
+// AccountItemDetail is an account item with the fields which are only included
+// in the response of the single account item.
+type AccountItemDetail struct {
+	AccountItem
+	// 検索可能:2, 検索不可：3
+	Searchable *int32 `json:"searchable,omitempty"`
+	// 減価償却累計額勘定科目
+	AccumulatedDepAccountItemName *string `json:"accumulated_dep_account_item_name,omitempty"`
+	// 減価償却累計額勘定科目ID
+	AccumulatedDepAccountItemID *int32 `json:"accumulated_dep_account_item_id,omitempty"`
+	// 品目（配列）
+	Items *[]AccountItemLink `json:"items,omitempty"`
+	// 取引先（配列）
+	Partners *[]AccountItemLink `json:"partners,omitempty"`
+}
+
+type AccountItemLink struct {
+	// ID
+	ID int32 `json:"id"`
+	// 名前
+	Name *string `json:"name,omitempty"`
+}
+
+type AccountItemParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// 勘定科目
+	AccountItem AccountItemParamsAccountItem `json:"account_item"`
+}
+
+type AccountItemParamsAccountItem struct {
+	// 勘定科目名 (30文字以内)
+	Name string `json:"name"`
+	// ショートカット1 (20文字以内)
+	Shortcut *string `json:"shortcut,omitempty"`
+	// ショートカット2(勘定科目コード)(20文字以内)
+	ShortcutNum *string `json:"shortcut_num,omitempty"`
+	// 決算書表示名（小カテゴリー）
+	GroupName string `json:"group_name"`
+	// 勘定科目のカテゴリーID
+	AccountCategoryID int32 `json:"account_category_id"`
+	// 収入取引相手勘定科目ID
+	CorrespondingIncomeID *int32 `json:"corresponding_income_id,omitempty"`
+	// 支出取引相手勘定科目ID
+	CorrespondingExpenseID *int32 `json:"corresponding_expense_id,omitempty"`
+	// 税区分コード
+	TaxCode int32 `json:"tax_code"`
+	// 減価償却累計額勘定科目 (作成時のみ指定できます)
+	AccumulatedDepAccountItemName *string `json:"accumulated_dep_account_item_name,omitempty"`
+	// 検索可能:2, 検索不可：3
+	Searchable *int32 `json:"searchable,omitempty"`
+	// 品目（配列）
+	Items []AccountItemLinkParams `json:"items,omitempty"`
+	// 取引先（配列）
+	Partners []AccountItemLinkParams `json:"partners,omitempty"`
+}
+
+type AccountItemLinkParams struct {
+	// ID
+	ID int32 `json:"id"`
+}
+
 func (c *Client) GetAccountItems(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*AccountItems, error) {
 	var result AccountItems
 
@@ -73,6 +145,54 @@ func (c *Client) GetAccountItems(ctx context.Context, reuseTokenSource oauth2.To
 	return &result, nil
 }
 
+func (c *Client) GetAccountItem(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, accountItemID int32) (*AccountItemDetail, error) {
+	var result AccountItemResponse
+
+	v, err := query.Values(nil)
+	if err != nil {
+		return nil, err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathAccountItems, fmt.Sprint(accountItemID)), http.MethodGet, reuseTokenSource, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.AccountItem, nil
+}
+
+func (c *Client) CreateAccountItem(ctx context.Context, reuseTokenSource oauth2.TokenSource, params AccountItemParams) (*AccountItemDetail, error) {
+	var result AccountItemResponse
+	err := c.call(ctx, APIPathAccountItems, http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.AccountItem, nil
+}
+
+func (c *Client) UpdateAccountItem(ctx context.Context, reuseTokenSource oauth2.TokenSource, accountItemID int32, params AccountItemParams) (*AccountItemDetail, error) {
+	var result AccountItemResponse
+	err := c.call(ctx, path.Join(APIPathAccountItems, fmt.Sprint(accountItemID)), http.MethodPut, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.AccountItem, nil
+}
+
+func (c *Client) DestroyAccountItem(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, accountItemID int32) error {
+	v, err := query.Values(nil)
+	if err != nil {
+		return err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathAccountItems, fmt.Sprint(accountItemID)), http.MethodDelete, reuseTokenSource, v, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (s *Client) GetAccountItemOrderList() []string {
 	str := new(AccountItem)
 
@@ -0,0 +1,100 @@
+package freee
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestAccountItemEndpoints(t *testing.T) {
+	t.Parallel()
+	accountItem := `{"account_item":{"id":10,"name":"広告宣伝費","tax_code":136,"account_category":"経費","account_category_id":15,
+		"available":true,"searchable":2,"items":[{"id":3,"name":"広告"}],"partners":[]}}`
+	searchable := int32(AccountItemSearchableEnabled)
+	corresponding := int32(20)
+	params := AccountItemParams{
+		CompanyID: 1,
+		AccountItem: AccountItemParamsAccountItem{
+			Name:                   "広告宣伝費",
+			GroupName:              "広告宣伝費",
+			AccountCategoryID:      15,
+			CorrespondingIncomeID:  &corresponding,
+			CorrespondingExpenseID: &corresponding,
+			TaxCode:                136,
+			Searchable:             &searchable,
+			Items:                  []AccountItemLinkParams{{ID: 3}},
+		},
+	}
+	body := `{"company_id":1,"account_item":{"name":"広告宣伝費","group_name":"広告宣伝費","account_category_id":15,
+		"corresponding_income_id":20,"corresponding_expense_id":20,"tax_code":136,"searchable":2,"items":[{"id":3}]}}`
+	check := func(got *AccountItemDetail) error {
+		if got.ID != 10 || got.Name != "広告宣伝費" || *got.Searchable != AccountItemSearchableEnabled || len(*got.Items) != 1 || *(*got.Items)[0].Name != "広告" {
+			return fmt.Errorf("unexpected account item: %+v", got)
+		}
+		return nil
+	}
+
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "get",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.GetAccountItem(ctx, ts, 1, 10)
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodGet, path: "/api/1/account_items/10", query: "company_id=1",
+			response: accountItem,
+		},
+		{
+			name: "create",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.CreateAccountItem(ctx, ts, params)
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodPost, path: "/api/1/account_items",
+			body: body, response: accountItem,
+		},
+		{
+			name: "update",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.UpdateAccountItem(ctx, ts, 10, params)
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodPut, path: "/api/1/account_items/10",
+			body: body, response: accountItem,
+		},
+		{
+			name: "destroy",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				return c.DestroyAccountItem(ctx, ts, 1, 10)
+			},
+			method: http.MethodDelete, path: "/api/1/account_items/10", query: "company_id=1",
+		},
+	})
+}
+
+func TestGetAccountItemOrderList(t *testing.T) {
+	t.Parallel()
+	// 詳細情報のみに含まれる項目は一覧の列に含めない
+	want := []string{
+		"id", "name", "tax_code", "shortcut,omitempty", "shortcut_num,omitempty", "default_tax_id,omitempty", "default_tax_code",
+		"account_category", "account_category_id", "categories", "available", "walletable_id", "group_name,omitempty",
+		"corresponding_income_name,omitempty", "corresponding_income_id,omitempty", "corresponding_expense_name,omitempty", "corresponding_expense_id,omitempty",
+	}
+	got := new(Client).GetAccountItemOrderList()
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected order list:\n%v\n%v", want, got)
+	}
+}
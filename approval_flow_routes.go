@@ -2,8 +2,11 @@ package freee
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"path"
 	"reflect"
+	"sort"
 
 	"github.com/google/go-querystring/query"
 	"golang.org/x/oauth2"
@@ -11,6 +14,13 @@ import (
 
 const (
 	APIPathApprovalFlowRoutes = "approval_flow_routes"
+
+	// 申請経路の申請種別
+	ApprovalFlowRouteUsageTxnApproval        = "TxnApproval"
+	ApprovalFlowRouteUsageExpenseApplication = "ExpenseApplication"
+	ApprovalFlowRouteUsagePaymentRequest     = "PaymentRequest"
+	ApprovalFlowRouteUsageApprovalRequest    = "ApprovalRequest"
+	ApprovalFlowRouteUsageDocApproval        = "DocApproval"
 )
 
 type GetApprovalFlowRoutesOpts struct {
@@ -42,6 +52,44 @@ type ApprovalFlowRoute struct {
 	RequestFormIDs *[]int32 `json:"request_form_ids,omitempty"`
 	// 基本経路として設定されているかどうか
 	DefaultRoute bool `json:"default_route"`
+	// 承認ステップ（配列）(申請経路の取得時のみ含まれる)
+	Steps *[]ApprovalFlowRouteStep `json:"steps,omitempty"`
+}
+
+type ApprovalFlowRouteResponse struct {
+	ApprovalFlowRoute ApprovalFlowRoute `json:"approval_flow_route"`
+}
+
+type ApprovalFlowRouteStep struct {
+	// 承認ステップID
+	ID int32 `json:"id"`
+	// 承認方法(predefined_user: メンバー指定(1人), selected_user: 申請時にメンバー指定, unspecified: 指定なし, and_resource: メンバー指定(複数、全員の承認), or_resource: メンバー指定(複数、1人の承認), and_resource_legacy: 部門役職指定(全員の承認), or_resource_legacy: 部門役職指定(1人の承認))
+	Type string `json:"type"`
+	// 次の承認ステップID
+	NextStepID *int32 `json:"next_step_id,omitempty"`
+	// 承認者（配列）
+	Approvers []ApprovalFlowRouteApprover `json:"approvers"`
+}
+
+type ApprovalFlowRouteApprover struct {
+	// 承認者のユーザーID (承認方法が部門役職指定の場合はnullになります)
+	UserID *int32 `json:"user_id,omitempty"`
+	// 承認者の指定方法(predefined_user: メンバー指定, section_position: 部門役職指定)
+	ResourceType string `json:"resource_type"`
+	// 承認者の部門ID (部門役職指定の場合のみ)
+	SectionID *int32 `json:"section_id,omitempty"`
+	// 承認者の役職ID (部門役職指定の場合のみ)
+	PositionID *int32 `json:"position_id,omitempty"`
+}
+
+// ApprovalFlowRouteRequest is the application for which ResolveApprovalFlowRoute
+// looks up a route. It has no applicant nor amount, since the freee API does not
+// return the conditions of the routes on them.
+type ApprovalFlowRouteRequest struct {
+	// 申請種別 (ApprovalFlowRouteUsage*)
+	Usage string
+	// 申請フォームID (各種申請の場合のみ)
+	FormID int32
 }
 
 func (c *Client) GetApprovalFlowRoutes(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*ApprovalFlowRoutes, error) {
@@ -60,6 +108,96 @@ func (c *Client) GetApprovalFlowRoutes(ctx context.Context, reuseTokenSource oau
 	return &result, nil
 }
 
+func (c *Client) GetApprovalFlowRoute(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, approvalFlowRouteID int32) (*ApprovalFlowRoute, error) {
+	var result ApprovalFlowRouteResponse
+
+	v, err := query.Values(nil)
+	if err != nil {
+		return nil, err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathApprovalFlowRoutes, fmt.Sprint(approvalFlowRouteID)), http.MethodGet, reuseTokenSource, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.ApprovalFlowRoute, nil
+}
+
+// ResolveApprovalFlowRoute fetches the routes of the usage and the form, and
+// returns the route to use for the application with its steps.
+// See SelectApprovalFlowRoute for the selection.
+// It makes two requests: one to list the routes, and one to get the selected route.
+func (c *Client) ResolveApprovalFlowRoute(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, req ApprovalFlowRouteRequest) (*ApprovalFlowRoute, error) {
+	list, err := c.GetApprovalFlowRoutes(ctx, reuseTokenSource, companyID, GetApprovalFlowRoutesOpts{
+		Usage:         req.Usage,
+		RequestFormID: req.FormID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := SelectApprovalFlowRoute(list.ApprovalFlowRoutes, req)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetApprovalFlowRoute(ctx, reuseTokenSource, companyID, selected.ID)
+}
+
+// SelectApprovalFlowRoute returns the route to use for the application among the
+// routes. A route is usable when its usages include the usage, and its
+// request_form_ids include the form if the form is given.
+// Routes created by the user are preferred to the ones created by the system
+// (definition_system), and then the default route. The remaining ties are
+// broken by the lowest ID.
+//
+// The freee API returns neither the applicants nor the amount conditions of the
+// routes, so the route is not selected on the applicant nor the amount. If the
+// routes of the company are split by amount, the caller must choose among them,
+// for example by the name of the route.
+func SelectApprovalFlowRoute(routes []ApprovalFlowRoute, req ApprovalFlowRouteRequest) (*ApprovalFlowRoute, error) {
+	var usable []ApprovalFlowRoute
+	for _, r := range routes {
+		if r.usableFor(req) {
+			usable = append(usable, r)
+		}
+	}
+	if len(usable) == 0 {
+		return nil, fmt.Errorf("no approval flow route is usable for usage %q and form %d", req.Usage, req.FormID)
+	}
+
+	sort.SliceStable(usable, func(i, j int) bool {
+		a, b := usable[i], usable[j]
+		if as, bs := a.DefinitionSystem != nil && *a.DefinitionSystem, b.DefinitionSystem != nil && *b.DefinitionSystem; as != bs {
+			return bs
+		}
+		if a.DefaultRoute != b.DefaultRoute {
+			return a.DefaultRoute
+		}
+		return a.ID < b.ID
+	})
+	return &usable[0], nil
+}
+
+func (r *ApprovalFlowRoute) usableFor(req ApprovalFlowRouteRequest) bool {
+	if req.Usage != "" && r.Usages != nil {
+		found := false
+		for _, u := range *r.Usages {
+			if u == req.Usage {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if req.FormID != 0 && r.RequestFormIDs != nil && !containsInt32(*r.RequestFormIDs, req.FormID) {
+		return false
+	}
+	return true
+}
+
 func (s *Client) GetApprovalFlowRouteOrderList() []string {
 	str := new(ApprovalFlowRoute)
 
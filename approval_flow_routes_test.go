@@ -0,0 +1,105 @@
+package freee
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestSelectApprovalFlowRoute(t *testing.T) {
+	t.Parallel()
+	boolPtr := func(b bool) *bool { return &b }
+	approvalRequest := &[]string{ApprovalFlowRouteUsageApprovalRequest}
+	routes := []ApprovalFlowRoute{
+		{ID: 1, DefinitionSystem: boolPtr(true), Usages: approvalRequest},
+		{ID: 2, DefaultRoute: true, Usages: approvalRequest, RequestFormIDs: &[]int32{10}},
+		{ID: 3, Usages: approvalRequest, RequestFormIDs: &[]int32{10, 20}},
+		{ID: 4, Usages: &[]string{ApprovalFlowRouteUsagePaymentRequest}},
+	}
+
+	tests := []struct {
+		name    string
+		req     ApprovalFlowRouteRequest
+		wantID  int32
+		wantErr bool
+	}{
+		{
+			name:   "default route",
+			req:    ApprovalFlowRouteRequest{Usage: ApprovalFlowRouteUsageApprovalRequest, FormID: 10},
+			wantID: 2,
+		},
+		{
+			name:   "form",
+			req:    ApprovalFlowRouteRequest{Usage: ApprovalFlowRouteUsageApprovalRequest, FormID: 20},
+			wantID: 3,
+		},
+		{
+			name:   "system route as fallback",
+			req:    ApprovalFlowRouteRequest{Usage: ApprovalFlowRouteUsageApprovalRequest, FormID: 30},
+			wantID: 1,
+		},
+		{
+			name:   "usage",
+			req:    ApprovalFlowRouteRequest{Usage: ApprovalFlowRouteUsagePaymentRequest},
+			wantID: 4,
+		},
+		{
+			name:    "no route",
+			req:     ApprovalFlowRouteRequest{Usage: ApprovalFlowRouteUsageExpenseApplication},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := SelectApprovalFlowRoute(routes, tt.req)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("unexpected route: %d", got.ID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.ID != tt.wantID {
+				t.Fatalf("unexpected route: %d, %d", tt.wantID, got.ID)
+			}
+		})
+	}
+}
+
+func TestResolveApprovalFlowRoute(t *testing.T) {
+	t.Parallel()
+	var requests []string
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Path+"?"+r.URL.RawQuery)
+		switch r.URL.Path {
+		case "/api/1/approval_flow_routes":
+			fmt.Fprint(w, `{"approval_flow_routes":[
+				{"id":1,"definition_system":true,"usages":["ApprovalRequest"],"default_route":false},
+				{"id":2,"usages":["ApprovalRequest"],"request_form_ids":[10],"default_route":true}]}`)
+		case "/api/1/approval_flow_routes/2":
+			fmt.Fprint(w, `{"approval_flow_route":{"id":2,"usages":["ApprovalRequest"],"default_route":true,"first_step_id":5,
+				"steps":[{"id":5,"type":"predefined_user","approvers":[{"user_id":7,"resource_type":"predefined_user"}]}]}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	got, err := client.ResolveApprovalFlowRoute(context.Background(), ts, 1, ApprovalFlowRouteRequest{Usage: ApprovalFlowRouteUsageApprovalRequest, FormID: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != 2 || got.FirstStepID == nil || *got.FirstStepID != 5 || got.Steps == nil || len(*got.Steps) != 1 {
+		t.Fatalf("unexpected route: %+v", got)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("unmatch request nums : %d, %d", 2, len(requests))
+	}
+	if want := "/api/1/approval_flow_routes?company_id=1&request_form_id=10&usage=ApprovalRequest"; requests[0] != want {
+		t.Fatalf("unexpected request: %s, %s", want, requests[0])
+	}
+}
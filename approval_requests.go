@@ -2,9 +2,12 @@ package freee
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"path"
 	"reflect"
+	"sort"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"golang.org/x/oauth2"
@@ -12,6 +15,35 @@ import (
 
 const (
 	APIPathApprovalRequests = "approval_requests"
+	APIPathActions          = "actions"
+	APIPathForms            = "forms"
+
+	// 申請ステータス
+	ApprovalStatusDraft      = "draft"
+	ApprovalStatusInProgress = "in_progress"
+	ApprovalStatusApproved   = "approved"
+	ApprovalStatusRejected   = "rejected"
+	ApprovalStatusFeedback   = "feedback"
+
+	// 承認操作
+	ApprovalActionApprove       = "approve"
+	ApprovalActionForceApprove  = "force_approve"
+	ApprovalActionCancel        = "cancel"
+	ApprovalActionReject        = "reject"
+	ApprovalActionFeedback      = "feedback"
+	ApprovalActionForceFeedback = "force_feedback"
+
+	// 各種申請の項目タイプ
+	RequestItemTypeTitle             = "title"
+	RequestItemTypeSingleLine        = "single_line"
+	RequestItemTypeMultiLine         = "multi_line"
+	RequestItemTypeSelect            = "select"
+	RequestItemTypeDate              = "date"
+	RequestItemTypeAmount            = "amount"
+	RequestItemTypeReceipt           = "receipt"
+	RequestItemTypeSection           = "section"
+	RequestItemTypePartner           = "partner"
+	RequestItemTypeNinjaSignDocument = "ninja_sign_document"
 )
 
 type GetApprovalRequestsOpts struct {
@@ -22,13 +54,56 @@ type GetApprovalRequestsOpts struct {
 	StartApplicationDate string `url:"start_application_date,omitempty"`
 	EndApplicationDate   string `url:"end_application_date,omitempty"`
 	ApplicantID          int32  `url:"applicant_id,omitempty"`
-	MinAmount            int32  `url:"min_amount,omitempty"`
-	MaxAmount            int32  `url:"max_amount,omitempty"`
+	MinAmount            int64  `url:"min_amount,omitempty"`
+	MaxAmount            int64  `url:"max_amount,omitempty"`
 	ApproverID           int32  `url:"approver_id,omitempty"`
 	Offset               int32  `url:"offset,omitempty"`
 	Limit                int32  `url:"limit,omitempty"`
 }
 
+// ApprovalActionParams is the params of an approval action on expense
+// applications, approval requests and payment requests.
+type ApprovalActionParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// 承認操作（approve: 承認する、force_approve: 代理承認する、cancel: 申請を取り消す、reject: 却下する、feedback: 申請者へ差し戻す、force_feedback: 承認済み・却下済みを取り消す）
+	ApprovalAction string `json:"approval_action"`
+	// 対象承認ステップID 現在の承認ステップIDを指定してください
+	TargetStepID int32 `json:"target_step_id"`
+	// 対象round。差し戻し等により申請がstepの最初からやり直しになるとroundの値が増えます。現在のroundを指定してください
+	TargetRound int32 `json:"target_round"`
+	// 次ステップの承認者のユーザーID
+	NextApproverID *int32 `json:"next_approver_id,omitempty"`
+}
+
+type ApprovalRequestParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// 申請日 (yyyy-mm-dd)
+	ApplicationDate string `json:"application_date"`
+	// 申請フォームID (作成時のみ指定できます)
+	FormID int32 `json:"form_id,omitempty"`
+	// 各種申請の項目一覧（配列）
+	RequestItems []RequestItemParams `json:"request_items"`
+	// 申請経路ID
+	ApprovalFlowRouteID int32 `json:"approval_flow_route_id"`
+	// 承認者のユーザーID (経路の最初のステップの承認方法が申請時に決定する場合は必須)
+	ApproverID *int32 `json:"approver_id,omitempty"`
+	// true: 下書き、false: 申請
+	Draft bool `json:"draft"`
+}
+
+type RequestItemParams struct {
+	// 項目ID
+	ID int32 `json:"id"`
+	// 項目の値
+	Value string `json:"value"`
+}
+
+type ApprovalRequestResponse struct {
+	ApprovalRequest ApprovalRequest `json:"approval_request"`
+}
+
 type ApprovalRequests struct {
 	ApprovalRequests []ApprovalRequest `json:"approval_requests"`
 }
@@ -57,11 +132,15 @@ type ApprovalRequest struct {
 	// 現在のround。差し戻し等により申請がstepの最初からやり直しになるとroundの値が増えます。
 	CurrentRound int32 `json:"current_round"`
 	// 取引ID (申請ステータス:statusがapprovedで、取引が存在する時のみdeal_idが表示されます)
-	DealID int32 `json:"deal_id"`
+	DealID uint64 `json:"deal_id"`
 	// 振替伝票のID (申請ステータス:statusがapprovedで、関連する振替伝票が存在する時のみmanual_journal_idが表示されます)
 	ManualJournalID int32 `json:"manual_journal_id"`
 	// 取引ステータス (申請ステータス:statusがapprovedで、取引が存在する時のみdeal_statusが表示されます settled:決済済み, unsettled:未決済)
 	DealStatus string `json:"deal_status"`
+	// 申請経路ID (各種申請の取得時のみ含まれる)
+	ApprovalFlowRouteID *int32 `json:"approval_flow_route_id,omitempty"`
+	// 承認者（配列）(各種申請の取得時のみ含まれる)
+	Approvers *[]Approver `json:"approvers,omitempty"`
 }
 
 type RequestItem struct {
@@ -77,6 +156,10 @@ type ApprovalRequestsForms struct {
 	ApprovalRequestsForms []ApprovalRequestsForm `json:"approval_request_forms"`
 }
 
+type ApprovalRequestsFormResponse struct {
+	ApprovalRequestsForm ApprovalRequestsForm `json:"approval_request_form"`
+}
+
 type ApprovalRequestsForm struct {
 	// 申請フォームID
 	ID int32 `json:"id"`
@@ -94,6 +177,25 @@ type ApprovalRequestsForm struct {
 	FormOrder int32 `json:"form_order"`
 	// 適用された経路数
 	RouteSettingCount int32 `json:"route_setting_count"`
+	// 申請フォームの項目一覧（配列）(申請フォームの取得時のみ含まれる)
+	Parts *[]ApprovalRequestsFormPart `json:"parts,omitempty"`
+}
+
+type ApprovalRequestsFormPart struct {
+	// 項目ID
+	ID int32 `json:"id"`
+	// 項目の表示順
+	Order int32 `json:"order"`
+	// 項目タイプ(title: 申請タイトル, single_line: 自由記述形式 1行, multi_line: 自由記述形式 複数行, select: プルダウン, date: 日付, amount: 金額, receipt: 添付ファイル, section: 部門ID, partner: 取引先ID, ninja_sign_document: 契約書（freeeサイン連携）)
+	Type string `json:"type"`
+	// 項目タイトル
+	Title string `json:"title"`
+	// 項目の説明
+	Annotation string `json:"annotation"`
+	// 必須項目かどうか
+	Required bool `json:"required"`
+	// 項目の選択肢（項目タイプがselectの場合のみ）
+	Values []string `json:"values,omitempty"`
 }
 
 func (c *Client) GetApprovalRequests(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*ApprovalRequests, error) {
@@ -112,6 +214,24 @@ func (c *Client) GetApprovalRequests(ctx context.Context, reuseTokenSource oauth
 	return &result, nil
 }
 
+func (c *Client) GetAllApprovalRequests(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetApprovalRequestsOpts) ([]ApprovalRequest, error) {
+	var requests []ApprovalRequest
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitApprovalRequests, &requests, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetApprovalRequests(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.ApprovalRequests, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
 func (c *Client) GetApprovalRequestsForms(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32) (*ApprovalRequestsForms, error) {
 	var result ApprovalRequestsForms
 
@@ -120,7 +240,7 @@ func (c *Client) GetApprovalRequestsForms(ctx context.Context, reuseTokenSource
 		return nil, err
 	}
 	SetCompanyID(&v, companyID)
-	err = c.call(ctx, path.Join(APIPathApprovalRequests, "forms"), http.MethodGet, reuseTokenSource, v, nil, &result)
+	err = c.call(ctx, path.Join(APIPathApprovalRequests, APIPathForms), http.MethodGet, reuseTokenSource, v, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -128,6 +248,198 @@ func (c *Client) GetApprovalRequestsForms(ctx context.Context, reuseTokenSource
 	return &result, nil
 }
 
+func (c *Client) GetApprovalRequestsForm(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, formID int32) (*ApprovalRequestsForm, error) {
+	var result ApprovalRequestsFormResponse
+
+	v, err := query.Values(nil)
+	if err != nil {
+		return nil, err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathApprovalRequests, APIPathForms, fmt.Sprint(formID)), http.MethodGet, reuseTokenSource, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.ApprovalRequestsForm, nil
+}
+
+func (c *Client) GetApprovalRequest(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, approvalRequestID int32) (*ApprovalRequest, error) {
+	var result ApprovalRequestResponse
+
+	v, err := query.Values(nil)
+	if err != nil {
+		return nil, err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathApprovalRequests, fmt.Sprint(approvalRequestID)), http.MethodGet, reuseTokenSource, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.ApprovalRequest, nil
+}
+
+func (c *Client) CreateApprovalRequest(ctx context.Context, reuseTokenSource oauth2.TokenSource, params ApprovalRequestParams) (*ApprovalRequest, error) {
+	var result ApprovalRequestResponse
+	err := c.call(ctx, APIPathApprovalRequests, http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.ApprovalRequest, nil
+}
+
+func (c *Client) UpdateApprovalRequest(ctx context.Context, reuseTokenSource oauth2.TokenSource, approvalRequestID int32, params ApprovalRequestParams) (*ApprovalRequest, error) {
+	var result ApprovalRequestResponse
+	params.FormID = 0
+	err := c.call(ctx, path.Join(APIPathApprovalRequests, fmt.Sprint(approvalRequestID)), http.MethodPut, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.ApprovalRequest, nil
+}
+
+func (c *Client) DestroyApprovalRequest(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, approvalRequestID int32) error {
+	v, err := query.Values(nil)
+	if err != nil {
+		return err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathApprovalRequests, fmt.Sprint(approvalRequestID)), http.MethodDelete, reuseTokenSource, v, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ActionApprovalRequest approves, rejects, sends back or withdraws (cancel) the approval request.
+func (c *Client) ActionApprovalRequest(ctx context.Context, reuseTokenSource oauth2.TokenSource, approvalRequestID int32, params ApprovalActionParams) (*ApprovalRequest, error) {
+	var result ApprovalRequestResponse
+	err := c.call(ctx, path.Join(APIPathApprovalRequests, fmt.Sprint(approvalRequestID), APIPathActions), http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.ApprovalRequest, nil
+}
+
+// ActionParams returns the params of the approval action on the current step and round.
+func (r *ApprovalRequest) ActionParams(approvalAction string) (*ApprovalActionParams, error) {
+	if r.CurrentStepID == 0 {
+		return nil, fmt.Errorf("approval request %d is not in progress", r.ID)
+	}
+	return &ApprovalActionParams{
+		CompanyID:      r.CompanyID,
+		ApprovalAction: approvalAction,
+		TargetStepID:   r.CurrentStepID,
+		TargetRound:    r.CurrentRound,
+	}, nil
+}
+
+// RequestItems converts the typed values of the parts, keyed by the part ID, to
+// the request items of the form. The value type depends on the part type:
+//   - title, single_line, multi_line, select: string (select must be one of the choices)
+//   - date: string (yyyy-mm-dd) or time.Time
+//   - amount: int64 or int
+//   - receipt, section, partner, ninja_sign_document: int32 ID
+//
+// Unknown parts, values of the wrong type and missing required parts are
+// reported as ValidationErrors. The form must be fetched by GetApprovalRequestsForm.
+func (f *ApprovalRequestsForm) RequestItems(values map[int32]interface{}) ([]RequestItemParams, error) {
+	if f.Parts == nil {
+		return nil, fmt.Errorf("parts of the approval request form %d are not fetched", f.ID)
+	}
+
+	var errs ValidationErrors
+	parts := make(map[int32]bool, len(*f.Parts))
+	var items []RequestItemParams
+	for _, p := range *f.Parts {
+		parts[p.ID] = true
+		field := fmt.Sprintf("request_items[%d]", p.ID)
+		value, ok := values[p.ID]
+		if !ok || value == nil {
+			if p.Required {
+				errs.add(ErrValidation, field, "%s is required", p.Title)
+			}
+			continue
+		}
+		s, err := p.format(value)
+		if err != nil {
+			errs.add(ErrValidation, field, "%s %s", p.Title, err)
+			continue
+		}
+		if s == "" && p.Required {
+			errs.add(ErrValidation, field, "%s is required", p.Title)
+			continue
+		}
+		items = append(items, RequestItemParams{ID: p.ID, Value: s})
+	}
+	var unknown []int32
+	for id := range values {
+		if !parts[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	sort.Slice(unknown, func(i, j int) bool { return unknown[i] < unknown[j] })
+	for _, id := range unknown {
+		errs.add(ErrValidation, fmt.Sprintf("request_items[%d]", id), "is not a part of the form %d", f.ID)
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return items, nil
+}
+
+// format returns the value of the part as the request item value.
+func (p *ApprovalRequestsFormPart) format(value interface{}) (string, error) {
+	switch p.Type {
+	case RequestItemTypeTitle, RequestItemTypeSingleLine, RequestItemTypeMultiLine:
+		s, ok := value.(string)
+		if !ok {
+			return "", fmt.Errorf("must be a string: %T", value)
+		}
+		return s, nil
+	case RequestItemTypeSelect:
+		s, ok := value.(string)
+		if !ok {
+			return "", fmt.Errorf("must be a string: %T", value)
+		}
+		for _, choice := range p.Values {
+			if s == choice {
+				return s, nil
+			}
+		}
+		return "", fmt.Errorf("must be one of %q: %q", p.Values, s)
+	case RequestItemTypeDate:
+		switch v := value.(type) {
+		case time.Time:
+			return v.Format(dateLayout), nil
+		case string:
+			if _, err := time.Parse(dateLayout, v); err != nil {
+				return "", fmt.Errorf("must be yyyy-mm-dd: %q", v)
+			}
+			return v, nil
+		}
+		return "", fmt.Errorf("must be a date: %T", value)
+	case RequestItemTypeAmount:
+		switch v := value.(type) {
+		case int64:
+			return fmt.Sprint(v), nil
+		case int:
+			return fmt.Sprint(v), nil
+		}
+		return "", fmt.Errorf("must be an amount: %T", value)
+	case RequestItemTypeReceipt, RequestItemTypeSection, RequestItemTypePartner, RequestItemTypeNinjaSignDocument:
+		v, ok := value.(int32)
+		if !ok {
+			return "", fmt.Errorf("must be an ID: %T", value)
+		}
+		return fmt.Sprint(v), nil
+	}
+	return "", fmt.Errorf("has unknown type %q", p.Type)
+}
+
 func (s *Client) GetApprovalRequestsFormOrderList() []string {
 	str := new(ApprovalRequestsForm)
 
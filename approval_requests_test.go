@@ -0,0 +1,169 @@
+package freee
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func TestApprovalRequestsFormRequestItems(t *testing.T) {
+	t.Parallel()
+	form := &ApprovalRequestsForm{
+		ID: 1,
+		Parts: &[]ApprovalRequestsFormPart{
+			{ID: 10, Type: RequestItemTypeTitle, Title: "タイトル", Required: true},
+			{ID: 11, Type: RequestItemTypeDate, Title: "日付", Required: true},
+			{ID: 12, Type: RequestItemTypeAmount, Title: "金額"},
+			{ID: 13, Type: RequestItemTypeSelect, Title: "区分", Values: []string{"国内", "海外"}},
+			{ID: 14, Type: RequestItemTypeSection, Title: "部門"},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		values     map[int32]interface{}
+		want       []RequestItemParams
+		wantFields []string
+	}{
+		{
+			name: "typed values",
+			values: map[int32]interface{}{
+				10: "出張申請",
+				11: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC),
+				12: int64(50000),
+				13: "海外",
+				14: int32(3),
+			},
+			want: []RequestItemParams{
+				{ID: 10, Value: "出張申請"},
+				{ID: 11, Value: "2021-06-01"},
+				{ID: 12, Value: "50000"},
+				{ID: 13, Value: "海外"},
+				{ID: 14, Value: "3"},
+			},
+		},
+		{
+			name:   "optional parts omitted",
+			values: map[int32]interface{}{10: "出張申請", 11: "2021-06-01"},
+			want: []RequestItemParams{
+				{ID: 10, Value: "出張申請"},
+				{ID: 11, Value: "2021-06-01"},
+			},
+		},
+		{
+			name: "invalid values",
+			values: map[int32]interface{}{
+				10: "",
+				11: "2021/06/01",
+				12: "50000",
+				13: "その他",
+				99: "unknown",
+			},
+			wantFields: []string{"request_items[10]", "request_items[11]", "request_items[12]", "request_items[13]", "request_items[99]"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := form.RequestItems(tt.values)
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Fatalf("unexpected items:\n%+v\n%+v", tt.want, got)
+				}
+				return
+			}
+			var errs ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var fields []string
+			for _, fe := range errs {
+				fields = append(fields, fe.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Fatalf("unexpected fields: %v, %v", tt.wantFields, fields)
+			}
+		})
+	}
+}
+
+func TestApprovalRequestEndpoints(t *testing.T) {
+	t.Parallel()
+	request := `{"approval_request":{"id":10,"company_id":1,"application_date":"2021-06-01","title":"備品購入","applicant_id":2,
+		"status":"in_progress","request_items":[{"id":1,"type":"title","value":"備品購入"}],"form_id":3,"current_step_id":5,"current_round":1}}`
+	params := ApprovalRequestParams{
+		CompanyID:           1,
+		ApplicationDate:     "2021-06-01",
+		FormID:              3,
+		RequestItems:        []RequestItemParams{{ID: 1, Value: "備品購入"}},
+		ApprovalFlowRouteID: 4,
+	}
+	body := `{"company_id":1,"application_date":"2021-06-01","form_id":3,"request_items":[{"id":1,"value":"備品購入"}],"approval_flow_route_id":4,"draft":false}`
+	// 申請フォームIDは作成時のみ送る
+	updateBody := `{"company_id":1,"application_date":"2021-06-01","request_items":[{"id":1,"value":"備品購入"}],"approval_flow_route_id":4,"draft":false}`
+
+	current := &ApprovalRequest{ID: 10, CompanyID: 1, CurrentStepID: 5, CurrentRound: 1}
+	action, err := current.ActionParams(ApprovalActionApprove)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&ApprovalRequest{ID: 11}).ActionParams(ApprovalActionApprove); err == nil {
+		t.Fatal("action on the request which is not in progress is accepted")
+	}
+
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "get",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.GetApprovalRequest(ctx, ts, 1, 10)
+				return err
+			},
+			method: http.MethodGet, path: "/api/1/approval_requests/10", query: "company_id=1",
+			response: request,
+		},
+		{
+			name: "create",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.CreateApprovalRequest(ctx, ts, params)
+				return err
+			},
+			method: http.MethodPost, path: "/api/1/approval_requests",
+			body: body, response: request,
+		},
+		{
+			name: "update",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.UpdateApprovalRequest(ctx, ts, 10, params)
+				return err
+			},
+			method: http.MethodPut, path: "/api/1/approval_requests/10",
+			body: updateBody, response: request,
+		},
+		{
+			name: "destroy",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				return c.DestroyApprovalRequest(ctx, ts, 1, 10)
+			},
+			method: http.MethodDelete, path: "/api/1/approval_requests/10", query: "company_id=1",
+		},
+		{
+			name: "action",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.ActionApprovalRequest(ctx, ts, 10, *action)
+				return err
+			},
+			method: http.MethodPost, path: "/api/1/approval_requests/10/actions",
+			body:     `{"company_id":1,"approval_action":"approve","target_step_id":5,"target_round":1}`,
+			response: request,
+		},
+	})
+}
@@ -49,6 +49,24 @@ func (c *Client) GetBanks(ctx context.Context, reuseTokenSource oauth2.TokenSour
 	return &result, nil
 }
 
+func (c *Client) GetAllBanks(ctx context.Context, reuseTokenSource oauth2.TokenSource, opts GetBanksOpts) ([]bank, error) {
+	var banks []bank
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitBanks, &banks, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetBanks(ctx, reuseTokenSource, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Banks, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return banks, nil
+}
+
 func (s *Client) GetBankOrderList() []string {
 	str := new(bank)
 
@@ -0,0 +1,711 @@
+package freee
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/oauth2"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// 同期対象の種類
+	ChartKindAccountItem = "account_item"
+	ChartKindItem        = "item"
+	ChartKindSection     = "section"
+	ChartKindTag         = "tag"
+	ChartKindSegmentTag  = "segment_tag"
+
+	// 同期の操作
+	ChartActionCreate = "create"
+	ChartActionUpdate = "update"
+)
+
+// ChartDefinition is the chart of accounts of a company: account items, items,
+// sections, tags and segment tags. Entries are matched by name between companies.
+// Fields with zero values are not synced, and entries missing in the definition
+// are not deleted from the target company.
+type ChartDefinition struct {
+	// 勘定科目
+	AccountItems []ChartAccountItem `json:"account_items,omitempty" yaml:"account_items,omitempty"`
+	// 品目
+	Items []ChartTag `json:"items,omitempty" yaml:"items,omitempty"`
+	// 部門
+	Sections []ChartSection `json:"sections,omitempty" yaml:"sections,omitempty"`
+	// メモタグ
+	Tags []ChartTag `json:"tags,omitempty" yaml:"tags,omitempty"`
+	// セグメントタグ（セグメントIDをキーとする）
+	SegmentTags map[int32][]ChartSegmentTag `json:"segment_tags,omitempty" yaml:"segment_tags,omitempty"`
+}
+
+type ChartAccountItem struct {
+	// 勘定科目名
+	Name string `json:"name" yaml:"name"`
+	// ショートカット1
+	Shortcut string `json:"shortcut,omitempty" yaml:"shortcut,omitempty"`
+	// ショートカット2(勘定科目コード)
+	ShortcutNum string `json:"shortcut_num,omitempty" yaml:"shortcut_num,omitempty"`
+	// 決算書表示名（小カテゴリー）
+	GroupName string `json:"group_name,omitempty" yaml:"group_name,omitempty"`
+	// 勘定科目カテゴリー名
+	AccountCategory string `json:"account_category,omitempty" yaml:"account_category,omitempty"`
+	// 税区分コード
+	TaxCode int32 `json:"tax_code,omitempty" yaml:"tax_code,omitempty"`
+	// 収入取引相手勘定科目名
+	CorrespondingIncomeName string `json:"corresponding_income_name,omitempty" yaml:"corresponding_income_name,omitempty"`
+	// 支出取引相手勘定科目名
+	CorrespondingExpenseName string `json:"corresponding_expense_name,omitempty" yaml:"corresponding_expense_name,omitempty"`
+}
+
+// ChartTag is an item or a tag of ChartDefinition.
+type ChartTag struct {
+	// 名前
+	Name string `json:"name" yaml:"name"`
+	// ショートカット１
+	Shortcut1 string `json:"shortcut1,omitempty" yaml:"shortcut1,omitempty"`
+	// ショートカット２
+	Shortcut2 string `json:"shortcut2,omitempty" yaml:"shortcut2,omitempty"`
+}
+
+type ChartSection struct {
+	// 部門名
+	Name string `json:"name" yaml:"name"`
+	// 正式名称
+	LongName string `json:"long_name,omitempty" yaml:"long_name,omitempty"`
+	// ショートカット１
+	Shortcut1 string `json:"shortcut1,omitempty" yaml:"shortcut1,omitempty"`
+	// ショートカット２
+	Shortcut2 string `json:"shortcut2,omitempty" yaml:"shortcut2,omitempty"`
+	// 親部門名
+	ParentName string `json:"parent_name,omitempty" yaml:"parent_name,omitempty"`
+}
+
+type ChartSegmentTag struct {
+	// セグメントタグ名
+	Name string `json:"name" yaml:"name"`
+	// 備考
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	// ショートカット１
+	Shortcut1 string `json:"shortcut1,omitempty" yaml:"shortcut1,omitempty"`
+	// ショートカット２
+	Shortcut2 string `json:"shortcut2,omitempty" yaml:"shortcut2,omitempty"`
+}
+
+// ChartChange is a change of a ChartSyncPlan.
+type ChartChange struct {
+	// 種類 (account_item, item, section, tag, segment_tag)
+	Kind string
+	// セグメントID (セグメントタグの場合のみ)
+	SegmentID int32
+	// 操作 (create, update)
+	Action string
+	// 名前
+	Name string
+	// 更新対象のID (updateの場合のみ)
+	ID int32
+	// 変更するフィールド (updateの場合のみ)
+	Fields []string
+
+	// the entry of the definition, and the current entry of the target company on update
+	def     interface{}
+	current interface{}
+}
+
+func (c ChartChange) String() string {
+	kind := c.Kind
+	if c.Kind == ChartKindSegmentTag {
+		kind = fmt.Sprintf("%s[%d]", c.Kind, c.SegmentID)
+	}
+	s := fmt.Sprintf("%s %s %s", c.Action, kind, c.Name)
+	if len(c.Fields) > 0 {
+		s += " (" + strings.Join(c.Fields, ", ") + ")"
+	}
+	return s
+}
+
+// ChartSyncPlan is the changes to align the chart of accounts of the company
+// with a definition. Changes are ordered so that the sections and account items
+// they refer to are created first.
+type ChartSyncPlan struct {
+	// 同期先の事業所ID
+	CompanyID int32
+	// 変更（配列）
+	Changes []ChartChange
+
+	target *chartState
+}
+
+// String returns the plan as a dry-run report, one change per line.
+func (p *ChartSyncPlan) String() string {
+	if len(p.Changes) == 0 {
+		return "no changes\n"
+	}
+	var b strings.Builder
+	for _, c := range p.Changes {
+		b.WriteString(c.String())
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// chartState is the current chart of accounts of a company.
+type chartState struct {
+	accountItems []AccountItem
+	items        []Item
+	sections     []Section
+	tags         []Tag
+	segmentTags  map[int32][]SegmentTag
+}
+
+// ParseChartDefinition parses a chart definition written in YAML or JSON.
+// Unknown fields are reported as errors.
+func ParseChartDefinition(data []byte) (*ChartDefinition, error) {
+	var def ChartDefinition
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&def); err != nil {
+			return nil, err
+		}
+		return &def, nil
+	}
+	if err := yaml.UnmarshalStrict(data, &def); err != nil {
+		return nil, err
+	}
+	return &def, nil
+}
+
+// GetChartDefinition returns the chart of accounts of the company, to be synced
+// to other companies. Segment tags are fetched only for the segmentIDs.
+func (c *Client) GetChartDefinition(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, segmentIDs ...int32) (*ChartDefinition, error) {
+	state, err := c.getChartState(ctx, reuseTokenSource, companyID, segmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	return state.definition(), nil
+}
+
+// PlanChartSync fetches the chart of accounts of the company, and returns the
+// changes to align it with the definition. Nothing is changed until the plan is
+// applied by ApplyChartSync.
+func (c *Client) PlanChartSync(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, def *ChartDefinition) (*ChartSyncPlan, error) {
+	segmentIDs := make([]int32, 0, len(def.SegmentTags))
+	for id := range def.SegmentTags {
+		segmentIDs = append(segmentIDs, id)
+	}
+	state, err := c.getChartState(ctx, reuseTokenSource, companyID, segmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	return planChartSync(companyID, def, state)
+}
+
+// ApplyChartSync applies the changes of the plan in order. It stops at the
+// first error, which tells the failed change; the preceding changes remain applied.
+// The plan must be made by PlanChartSync.
+func (c *Client) ApplyChartSync(ctx context.Context, reuseTokenSource oauth2.TokenSource, plan *ChartSyncPlan) error {
+	if plan.target == nil {
+		return errors.New("chart sync plan is not made by PlanChartSync")
+	}
+	sectionIDs := map[string]int32{}
+	for _, s := range plan.target.sections {
+		sectionIDs[s.Name] = s.ID
+	}
+	accountItemIDs := map[string]int32{}
+	categoryIDs := map[string]int32{}
+	for _, a := range plan.target.accountItems {
+		accountItemIDs[a.Name] = a.ID
+		categoryIDs[a.AccountCategory] = a.AccountCategoryID
+	}
+
+	for _, change := range plan.Changes {
+		var err error
+		switch change.Kind {
+		case ChartKindItem:
+			params := change.itemParams(plan.CompanyID)
+			if change.Action == ChartActionCreate {
+				_, err = c.CreateItem(ctx, reuseTokenSource, params)
+			} else {
+				_, err = c.UpdateItem(ctx, reuseTokenSource, params, change.ID)
+			}
+		case ChartKindTag:
+			params := change.tagParams(plan.CompanyID)
+			if change.Action == ChartActionCreate {
+				_, err = c.CreateTag(ctx, reuseTokenSource, params)
+			} else {
+				_, err = c.UpdateTag(ctx, reuseTokenSource, change.ID, params)
+			}
+		case ChartKindSegmentTag:
+			params := change.segmentTagParams(plan.CompanyID)
+			if change.Action == ChartActionCreate {
+				_, err = c.CreateSegmentTag(ctx, reuseTokenSource, change.SegmentID, params)
+			} else {
+				_, err = c.UpdateSegmentTag(ctx, reuseTokenSource, change.SegmentID, change.ID, params)
+			}
+		case ChartKindSection:
+			params := change.sectionParams(plan.CompanyID, sectionIDs)
+			var section *Section
+			if change.Action == ChartActionCreate {
+				section, err = c.CreateSection(ctx, reuseTokenSource, params)
+			} else {
+				section, err = c.UpdateSection(ctx, reuseTokenSource, change.ID, params)
+			}
+			if err == nil {
+				sectionIDs[section.Name] = section.ID
+			}
+		case ChartKindAccountItem:
+			params := change.accountItemParams(plan.CompanyID, accountItemIDs, categoryIDs)
+			var item *AccountItemDetail
+			if change.Action == ChartActionCreate {
+				item, err = c.CreateAccountItem(ctx, reuseTokenSource, params)
+			} else {
+				item, err = c.UpdateAccountItem(ctx, reuseTokenSource, change.ID, params)
+			}
+			if err == nil {
+				accountItemIDs[item.Name] = item.ID
+			}
+		default:
+			err = fmt.Errorf("unknown kind %q", change.Kind)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", change, err)
+		}
+	}
+	return nil
+}
+
+func (c *Client) getChartState(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, segmentIDs []int32) (*chartState, error) {
+	state := &chartState{segmentTags: map[int32][]SegmentTag{}}
+
+	accountItems, err := c.GetAccountItems(ctx, reuseTokenSource, companyID, nil)
+	if err != nil {
+		return nil, err
+	}
+	state.accountItems = accountItems.AccountItems
+
+	state.items, err = c.GetAllItems(ctx, reuseTokenSource, companyID, GetItemsOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	sections, err := c.GetSections(ctx, reuseTokenSource, companyID)
+	if err != nil {
+		return nil, err
+	}
+	state.sections = sections.Sections
+
+	state.tags, err = c.GetAllTags(ctx, reuseTokenSource, companyID, GetTagsOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range segmentIDs {
+		tags, err := c.GetAllSegmentTags(ctx, reuseTokenSource, companyID, id, GetSegmentTagsOpts{})
+		if err != nil {
+			return nil, err
+		}
+		state.segmentTags[id] = tags
+	}
+	return state, nil
+}
+
+func (s *chartState) definition() *ChartDefinition {
+	def := &ChartDefinition{}
+	for _, a := range s.accountItems {
+		def.AccountItems = append(def.AccountItems, ChartAccountItem{
+			Name:                     a.Name,
+			Shortcut:                 stringValue(a.Shortcut),
+			ShortcutNum:              stringValue(a.ShortcutNum),
+			GroupName:                stringValue(a.GroupName),
+			AccountCategory:          a.AccountCategory,
+			TaxCode:                  a.TaxCode,
+			CorrespondingIncomeName:  stringValue(a.CorrespondingIncomeName),
+			CorrespondingExpenseName: stringValue(a.CorrespondingExpenseName),
+		})
+	}
+	for _, i := range s.items {
+		def.Items = append(def.Items, ChartTag{Name: i.Name, Shortcut1: stringValue(i.Shortcut1), Shortcut2: stringValue(i.Shortcut2)})
+	}
+	sectionNames := map[int32]string{}
+	for _, sec := range s.sections {
+		sectionNames[sec.ID] = sec.Name
+	}
+	for _, sec := range s.sections {
+		def.Sections = append(def.Sections, ChartSection{
+			Name:       sec.Name,
+			LongName:   stringValue(sec.LongName),
+			Shortcut1:  stringValue(sec.Shortcut1),
+			Shortcut2:  stringValue(sec.Shortcut2),
+			ParentName: sectionNames[int32Value(sec.ParentID)],
+		})
+	}
+	for _, t := range s.tags {
+		def.Tags = append(def.Tags, ChartTag{Name: t.Name, Shortcut1: stringValue(t.Shortcut1), Shortcut2: stringValue(t.Shortcut2)})
+	}
+	for id, tags := range s.segmentTags {
+		if def.SegmentTags == nil {
+			def.SegmentTags = map[int32][]ChartSegmentTag{}
+		}
+		list := make([]ChartSegmentTag, 0, len(tags))
+		for _, t := range tags {
+			list = append(list, ChartSegmentTag{
+				Name:        t.Name,
+				Description: stringValue(t.Description),
+				Shortcut1:   stringValue(t.Shortcut1),
+				Shortcut2:   stringValue(t.Shortcut2),
+			})
+		}
+		def.SegmentTags[id] = list
+	}
+	return def
+}
+
+// chartFields collects the fields of a definition entry which differ from the target.
+type chartFields []string
+
+func (f *chartFields) diff(field string, want, got string) {
+	if want != "" && want != got {
+		*f = append(*f, field)
+	}
+}
+
+func planChartSync(companyID int32, def *ChartDefinition, state *chartState) (*ChartSyncPlan, error) {
+	plan := &ChartSyncPlan{CompanyID: companyID, target: state}
+
+	items := map[string]Item{}
+	for _, i := range state.items {
+		items[i.Name] = i
+	}
+	if err := checkChartNames(ChartKindItem, len(def.Items), func(i int) string { return def.Items[i].Name }); err != nil {
+		return nil, err
+	}
+	for i := range def.Items {
+		d := &def.Items[i]
+		change := ChartChange{Kind: ChartKindItem, Action: ChartActionCreate, Name: d.Name, def: d}
+		if cur, ok := items[d.Name]; ok {
+			var fields chartFields
+			fields.diff("shortcut1", d.Shortcut1, stringValue(cur.Shortcut1))
+			fields.diff("shortcut2", d.Shortcut2, stringValue(cur.Shortcut2))
+			if len(fields) == 0 {
+				continue
+			}
+			change.Action, change.ID, change.Fields, change.current = ChartActionUpdate, cur.ID, fields, cur
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	tags := map[string]Tag{}
+	for _, t := range state.tags {
+		tags[t.Name] = t
+	}
+	if err := checkChartNames(ChartKindTag, len(def.Tags), func(i int) string { return def.Tags[i].Name }); err != nil {
+		return nil, err
+	}
+	for i := range def.Tags {
+		d := &def.Tags[i]
+		change := ChartChange{Kind: ChartKindTag, Action: ChartActionCreate, Name: d.Name, def: d}
+		if cur, ok := tags[d.Name]; ok {
+			var fields chartFields
+			fields.diff("shortcut1", d.Shortcut1, stringValue(cur.Shortcut1))
+			fields.diff("shortcut2", d.Shortcut2, stringValue(cur.Shortcut2))
+			if len(fields) == 0 {
+				continue
+			}
+			change.Action, change.ID, change.Fields, change.current = ChartActionUpdate, cur.ID, fields, cur
+		}
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	segmentIDs := make([]int32, 0, len(def.SegmentTags))
+	for id := range def.SegmentTags {
+		segmentIDs = append(segmentIDs, id)
+	}
+	sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+	for _, segmentID := range segmentIDs {
+		defs := def.SegmentTags[segmentID]
+		kind := fmt.Sprintf("%s[%d]", ChartKindSegmentTag, segmentID)
+		if err := checkChartNames(kind, len(defs), func(i int) string { return defs[i].Name }); err != nil {
+			return nil, err
+		}
+		segmentTags := map[string]SegmentTag{}
+		for _, t := range state.segmentTags[segmentID] {
+			segmentTags[t.Name] = t
+		}
+		for i := range defs {
+			d := &defs[i]
+			change := ChartChange{Kind: ChartKindSegmentTag, SegmentID: segmentID, Action: ChartActionCreate, Name: d.Name, def: d}
+			if cur, ok := segmentTags[d.Name]; ok {
+				var fields chartFields
+				fields.diff("description", d.Description, stringValue(cur.Description))
+				fields.diff("shortcut1", d.Shortcut1, stringValue(cur.Shortcut1))
+				fields.diff("shortcut2", d.Shortcut2, stringValue(cur.Shortcut2))
+				if len(fields) == 0 {
+					continue
+				}
+				change.Action, change.ID, change.Fields, change.current = ChartActionUpdate, cur.ID, fields, cur
+			}
+			plan.Changes = append(plan.Changes, change)
+		}
+	}
+
+	sectionChanges, err := planSections(def, state)
+	if err != nil {
+		return nil, err
+	}
+	plan.Changes = append(plan.Changes, sectionChanges...)
+
+	accountItemChanges, err := planAccountItems(def, state)
+	if err != nil {
+		return nil, err
+	}
+	plan.Changes = append(plan.Changes, accountItemChanges...)
+
+	return plan, nil
+}
+
+func planSections(def *ChartDefinition, state *chartState) ([]ChartChange, error) {
+	if err := checkChartNames(ChartKindSection, len(def.Sections), func(i int) string { return def.Sections[i].Name }); err != nil {
+		return nil, err
+	}
+	sections := map[string]Section{}
+	sectionNames := map[int32]string{}
+	known := map[string]bool{}
+	for _, s := range state.sections {
+		sections[s.Name] = s
+		sectionNames[s.ID] = s.Name
+		known[s.Name] = true
+	}
+
+	var changes []ChartChange
+	for i := range def.Sections {
+		d := &def.Sections[i]
+		change := ChartChange{Kind: ChartKindSection, Action: ChartActionCreate, Name: d.Name, def: d}
+		if cur, ok := sections[d.Name]; ok {
+			var fields chartFields
+			fields.diff("long_name", d.LongName, stringValue(cur.LongName))
+			fields.diff("shortcut1", d.Shortcut1, stringValue(cur.Shortcut1))
+			fields.diff("shortcut2", d.Shortcut2, stringValue(cur.Shortcut2))
+			fields.diff("parent_name", d.ParentName, sectionNames[int32Value(cur.ParentID)])
+			if len(fields) == 0 {
+				continue
+			}
+			change.Action, change.ID, change.Fields, change.current = ChartActionUpdate, cur.ID, fields, cur
+		}
+		changes = append(changes, change)
+	}
+	return orderChartChanges(changes, known, func(c ChartChange) []string {
+		d := c.def.(*ChartSection)
+		if d.ParentName == "" {
+			return nil
+		}
+		return []string{d.ParentName}
+	})
+}
+
+func planAccountItems(def *ChartDefinition, state *chartState) ([]ChartChange, error) {
+	if err := checkChartNames(ChartKindAccountItem, len(def.AccountItems), func(i int) string { return def.AccountItems[i].Name }); err != nil {
+		return nil, err
+	}
+	accountItems := map[string]AccountItem{}
+	categories := map[string]bool{}
+	known := map[string]bool{}
+	for _, a := range state.accountItems {
+		accountItems[a.Name] = a
+		categories[a.AccountCategory] = true
+		known[a.Name] = true
+	}
+
+	var changes []ChartChange
+	for i := range def.AccountItems {
+		d := &def.AccountItems[i]
+		if d.AccountCategory != "" && !categories[d.AccountCategory] {
+			return nil, fmt.Errorf("%s %s: unknown account category %q", ChartKindAccountItem, d.Name, d.AccountCategory)
+		}
+		change := ChartChange{Kind: ChartKindAccountItem, Action: ChartActionCreate, Name: d.Name, def: d}
+		cur, ok := accountItems[d.Name]
+		if !ok {
+			if d.GroupName == "" || d.AccountCategory == "" || d.TaxCode == 0 || d.CorrespondingIncomeName == "" || d.CorrespondingExpenseName == "" {
+				return nil, fmt.Errorf("%s %s: group_name, account_category, tax_code and corresponding account items are required to create", ChartKindAccountItem, d.Name)
+			}
+			if d.CorrespondingIncomeName == d.Name || d.CorrespondingExpenseName == d.Name {
+				return nil, fmt.Errorf("%s %s: a new account item cannot be its own corresponding account item", ChartKindAccountItem, d.Name)
+			}
+			changes = append(changes, change)
+			continue
+		}
+		var fields chartFields
+		fields.diff("shortcut", d.Shortcut, stringValue(cur.Shortcut))
+		fields.diff("shortcut_num", d.ShortcutNum, stringValue(cur.ShortcutNum))
+		fields.diff("group_name", d.GroupName, stringValue(cur.GroupName))
+		fields.diff("account_category", d.AccountCategory, cur.AccountCategory)
+		if d.TaxCode != 0 && d.TaxCode != cur.TaxCode {
+			fields = append(fields, "tax_code")
+		}
+		fields.diff("corresponding_income_name", d.CorrespondingIncomeName, stringValue(cur.CorrespondingIncomeName))
+		fields.diff("corresponding_expense_name", d.CorrespondingExpenseName, stringValue(cur.CorrespondingExpenseName))
+		if len(fields) == 0 {
+			continue
+		}
+		change.Action, change.ID, change.Fields, change.current = ChartActionUpdate, cur.ID, fields, cur
+		changes = append(changes, change)
+	}
+	return orderChartChanges(changes, known, func(c ChartChange) []string {
+		d := c.def.(*ChartAccountItem)
+		var deps []string
+		for _, name := range []string{d.CorrespondingIncomeName, d.CorrespondingExpenseName} {
+			if name != "" && name != d.Name {
+				deps = append(deps, name)
+			}
+		}
+		return deps
+	})
+}
+
+func checkChartNames(kind string, n int, name func(i int) string) error {
+	seen := map[string]bool{}
+	for i := 0; i < n; i++ {
+		s := name(i)
+		if s == "" {
+			return fmt.Errorf("%s[%d]: name is required", kind, i)
+		}
+		if seen[s] {
+			return fmt.Errorf("%s %s: duplicated in the definition", kind, s)
+		}
+		seen[s] = true
+	}
+	return nil
+}
+
+// orderChartChanges orders the changes so that the names they depend on exist
+// before them. known is the names which already exist in the target company.
+func orderChartChanges(changes []ChartChange, known map[string]bool, deps func(ChartChange) []string) ([]ChartChange, error) {
+	creating := map[string]bool{}
+	for _, c := range changes {
+		if c.Action == ChartActionCreate {
+			creating[c.Name] = true
+		}
+	}
+
+	ordered := make([]ChartChange, 0, len(changes))
+	pending := changes
+	for len(pending) > 0 {
+		var next []ChartChange
+		for _, c := range pending {
+			ready := true
+			for _, dep := range deps(c) {
+				if known[dep] {
+					continue
+				}
+				if !creating[dep] {
+					return nil, fmt.Errorf("%s %s: unknown %s %q", c.Kind, c.Name, c.Kind, dep)
+				}
+				ready = false
+			}
+			if !ready {
+				next = append(next, c)
+				continue
+			}
+			ordered = append(ordered, c)
+			known[c.Name] = true
+		}
+		if len(next) == len(pending) {
+			return nil, fmt.Errorf("%s %s: circular reference", next[0].Kind, next[0].Name)
+		}
+		pending = next
+	}
+	return ordered, nil
+}
+
+func (c *ChartChange) itemParams(companyID int32) ItemParams {
+	d := c.def.(*ChartTag)
+	params := ItemParams{CompanyID: companyID, Name: d.Name}
+	if cur, ok := c.current.(Item); ok {
+		params.Shortcut1, params.Shortcut2 = cur.Shortcut1, cur.Shortcut2
+	}
+	params.Shortcut1 = overrideString(params.Shortcut1, d.Shortcut1)
+	params.Shortcut2 = overrideString(params.Shortcut2, d.Shortcut2)
+	return params
+}
+
+func (c *ChartChange) tagParams(companyID int32) TagParams {
+	d := c.def.(*ChartTag)
+	params := TagParams{CompanyID: companyID, Name: d.Name}
+	if cur, ok := c.current.(Tag); ok {
+		params.Shortcut1, params.Shortcut2 = cur.Shortcut1, cur.Shortcut2
+	}
+	params.Shortcut1 = overrideString(params.Shortcut1, d.Shortcut1)
+	params.Shortcut2 = overrideString(params.Shortcut2, d.Shortcut2)
+	return params
+}
+
+func (c *ChartChange) segmentTagParams(companyID int32) SegmentTagParams {
+	d := c.def.(*ChartSegmentTag)
+	params := SegmentTagParams{CompanyID: companyID, Name: d.Name}
+	if cur, ok := c.current.(SegmentTag); ok {
+		params.Description, params.Shortcut1, params.Shortcut2 = cur.Description, cur.Shortcut1, cur.Shortcut2
+	}
+	params.Description = overrideString(params.Description, d.Description)
+	params.Shortcut1 = overrideString(params.Shortcut1, d.Shortcut1)
+	params.Shortcut2 = overrideString(params.Shortcut2, d.Shortcut2)
+	return params
+}
+
+func (c *ChartChange) sectionParams(companyID int32, sectionIDs map[string]int32) SectionParams {
+	d := c.def.(*ChartSection)
+	params := SectionParams{CompanyID: companyID, Name: d.Name}
+	if cur, ok := c.current.(Section); ok {
+		params.LongName, params.Shortcut1, params.Shortcut2, params.ParentID = cur.LongName, cur.Shortcut1, cur.Shortcut2, cur.ParentID
+	}
+	params.LongName = overrideString(params.LongName, d.LongName)
+	params.Shortcut1 = overrideString(params.Shortcut1, d.Shortcut1)
+	params.Shortcut2 = overrideString(params.Shortcut2, d.Shortcut2)
+	if d.ParentName != "" {
+		id := sectionIDs[d.ParentName]
+		params.ParentID = &id
+	}
+	return params
+}
+
+func (c *ChartChange) accountItemParams(companyID int32, accountItemIDs map[string]int32, categoryIDs map[string]int32) AccountItemParams {
+	d := c.def.(*ChartAccountItem)
+	item := AccountItemParamsAccountItem{Name: d.Name}
+	if cur, ok := c.current.(AccountItem); ok {
+		item.Shortcut, item.ShortcutNum = cur.Shortcut, cur.ShortcutNum
+		item.GroupName = stringValue(cur.GroupName)
+		item.AccountCategoryID = cur.AccountCategoryID
+		item.TaxCode = cur.TaxCode
+		item.CorrespondingIncomeID = cloneInt32(cur.CorrespondingIncomeID)
+		item.CorrespondingExpenseID = cloneInt32(cur.CorrespondingExpenseID)
+	}
+	item.Shortcut = overrideString(item.Shortcut, d.Shortcut)
+	item.ShortcutNum = overrideString(item.ShortcutNum, d.ShortcutNum)
+	if d.GroupName != "" {
+		item.GroupName = d.GroupName
+	}
+	if d.AccountCategory != "" {
+		item.AccountCategoryID = categoryIDs[d.AccountCategory]
+	}
+	if d.TaxCode != 0 {
+		item.TaxCode = d.TaxCode
+	}
+	// 取引相手勘定科目は ID が分かる場合のみ指定する
+	if id, ok := accountItemIDs[d.CorrespondingIncomeName]; ok && d.CorrespondingIncomeName != "" {
+		item.CorrespondingIncomeID = &id
+	}
+	if id, ok := accountItemIDs[d.CorrespondingExpenseName]; ok && d.CorrespondingExpenseName != "" {
+		item.CorrespondingExpenseID = &id
+	}
+	return AccountItemParams{CompanyID: companyID, AccountItem: item}
+}
+
+func overrideString(cur *string, want string) *string {
+	if want == "" {
+		return cur
+	}
+	return &want
+}
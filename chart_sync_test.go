@@ -0,0 +1,234 @@
+package freee
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestParseChartDefinition(t *testing.T) {
+	t.Parallel()
+	want := &ChartDefinition{
+		Items:       []ChartTag{{Name: "交通費", Shortcut1: "KOTSU"}},
+		Sections:    []ChartSection{{Name: "営業部"}, {Name: "東京営業", ParentName: "営業部"}},
+		SegmentTags: map[int32][]ChartSegmentTag{1: {{Name: "案件A"}}},
+	}
+	tests := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "yaml",
+			data: `
+items:
+  - name: 交通費
+    shortcut1: KOTSU
+sections:
+  - name: 営業部
+  - name: 東京営業
+    parent_name: 営業部
+segment_tags:
+  1:
+    - name: 案件A
+`,
+		},
+		{
+			name: "json",
+			data: `{"items":[{"name":"交通費","shortcut1":"KOTSU"}],"sections":[{"name":"営業部"},{"name":"東京営業","parent_name":"営業部"}],"segment_tags":{"1":[{"name":"案件A"}]}}`,
+		},
+		{
+			name:    "unknown field",
+			data:    "items:\n  - name: 交通費\n    shortcut: KOTSU\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseChartDefinition([]byte(tt.data))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("unexpected definition:\n%+v\n%+v", want, got)
+			}
+		})
+	}
+}
+
+func TestPlanChartSync(t *testing.T) {
+	t.Parallel()
+	state := &chartState{
+		accountItems: []AccountItem{
+			{ID: 1, Name: "現金", AccountCategory: "現金・預金", AccountCategoryID: 10, TaxCode: 2, GroupName: stringPtr("現金")},
+			{ID: 2, Name: "旅費交通費", AccountCategory: "経費", AccountCategoryID: 20, TaxCode: 136, GroupName: stringPtr("販売管理費"),
+				CorrespondingIncomeName: stringPtr("現金"), CorrespondingExpenseName: stringPtr("現金")},
+		},
+		items:    []Item{{ID: 5, Name: "交通費", Shortcut1: stringPtr("KOTSU")}},
+		sections: []Section{{ID: 7, Name: "営業部"}},
+		tags:     []Tag{{ID: 8, Name: "東京", Shortcut1: stringPtr("TKY")}},
+	}
+
+	tests := []struct {
+		name    string
+		def     ChartDefinition
+		want    string
+		wantErr string
+	}{
+		{
+			name: "no changes",
+			def: ChartDefinition{
+				Items: []ChartTag{{Name: "交通費", Shortcut1: "KOTSU"}},
+				Tags:  []ChartTag{{Name: "東京"}},
+			},
+			want: "no changes\n",
+		},
+		{
+			name: "creates and updates in dependency order",
+			def: ChartDefinition{
+				AccountItems: []ChartAccountItem{
+					{Name: "出張手当", GroupName: "販売管理費", AccountCategory: "経費", TaxCode: 136, CorrespondingIncomeName: "仮払金", CorrespondingExpenseName: "現金"},
+					{Name: "仮払金", GroupName: "その他流動資産", AccountCategory: "現金・預金", TaxCode: 2, CorrespondingIncomeName: "現金", CorrespondingExpenseName: "現金"},
+					{Name: "旅費交通費", ShortcutNum: "741"},
+				},
+				Items:       []ChartTag{{Name: "宿泊費"}},
+				Sections:    []ChartSection{{Name: "東京営業", ParentName: "関東営業"}, {Name: "関東営業", ParentName: "営業部"}},
+				Tags:        []ChartTag{{Name: "東京", Shortcut1: "TOKYO"}},
+				SegmentTags: map[int32][]ChartSegmentTag{1: {{Name: "案件A"}}},
+			},
+			want: strings.Join([]string{
+				"create item 宿泊費",
+				"update tag 東京 (shortcut1)",
+				"create segment_tag[1] 案件A",
+				"create section 関東営業",
+				"create section 東京営業",
+				"create account_item 仮払金",
+				"update account_item 旅費交通費 (shortcut_num)",
+				"create account_item 出張手当",
+			}, "\n") + "\n",
+		},
+		{
+			name:    "unknown parent section",
+			def:     ChartDefinition{Sections: []ChartSection{{Name: "東京営業", ParentName: "関東営業"}}},
+			wantErr: `section 東京営業: unknown section "関東営業"`,
+		},
+		{
+			name:    "unknown account category",
+			def:     ChartDefinition{AccountItems: []ChartAccountItem{{Name: "仮払金", AccountCategory: "資産"}}},
+			wantErr: `account_item 仮払金: unknown account category "資産"`,
+		},
+		{
+			name:    "missing required fields",
+			def:     ChartDefinition{AccountItems: []ChartAccountItem{{Name: "仮払金"}}},
+			wantErr: "account_item 仮払金: group_name",
+		},
+		{
+			name:    "duplicated name",
+			def:     ChartDefinition{Tags: []ChartTag{{Name: "東京"}, {Name: "東京"}}},
+			wantErr: "tag 東京: duplicated",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			plan, err := planChartSync(1, &tt.def, state)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("unexpected error: %q, %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := plan.String(); got != tt.want {
+				t.Fatalf("unexpected plan:\n%s\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestApplyChartSync(t *testing.T) {
+	t.Parallel()
+	var (
+		mu       sync.Mutex
+		requests []string
+		nextID   = int32(100)
+	)
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode body: %v", err)
+		}
+		delete(body, "company_id")
+		b, _ := json.Marshal(body)
+		requests = append(requests, fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, b))
+
+		nextID++
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/sections"):
+			fmt.Fprintf(w, `{"section":{"id":%d,"name":%q}}`, nextID, body["name"])
+		case strings.Contains(r.URL.Path, "/account_items"):
+			item := body["account_item"].(map[string]interface{})
+			fmt.Fprintf(w, `{"account_item":{"id":%d,"name":%q}}`, nextID, item["name"])
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	})
+
+	state := &chartState{
+		accountItems: []AccountItem{
+			{ID: 1, Name: "現金", AccountCategory: "現金・預金", AccountCategoryID: 10},
+			{ID: 2, Name: "仮受金", AccountCategory: "流動負債", AccountCategoryID: 20, TaxCode: 2, GroupName: stringPtr("その他流動負債")},
+		},
+		sections: []Section{{ID: 7, Name: "営業部"}},
+		tags:     []Tag{{ID: 8, Name: "東京", Shortcut1: stringPtr("TKY"), Shortcut2: stringPtr("とうきょう")}},
+	}
+	def := &ChartDefinition{
+		AccountItems: []ChartAccountItem{
+			{Name: "仮払金", GroupName: "その他流動資産", AccountCategory: "現金・預金", TaxCode: 2, CorrespondingIncomeName: "現金", CorrespondingExpenseName: "現金"},
+			{Name: "仮受金", GroupName: "預り金"},
+		},
+		Sections: []ChartSection{{Name: "東京営業", ParentName: "関東営業"}, {Name: "関東営業", ParentName: "営業部"}},
+		Tags:     []ChartTag{{Name: "東京", Shortcut1: "TOKYO"}},
+	}
+	plan, err := planChartSync(1, def, state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := client.ApplyChartSync(context.Background(), ts, plan); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`PUT /api/1/tags/8 {"name":"東京","shortcut1":"TOKYO","shortcut2":"とうきょう"}`,
+		`POST /api/1/sections {"name":"関東営業","parent_id":7}`,
+		`POST /api/1/sections {"name":"東京営業","parent_id":102}`,
+		`POST /api/1/account_items {"account_item":{"account_category_id":10,"corresponding_expense_id":1,"corresponding_income_id":1,"group_name":"その他流動資産","name":"仮払金","tax_code":2}}`,
+		// 取引相手勘定科目が分からない場合は送らない
+		`PUT /api/1/account_items/2 {"account_item":{"account_category_id":20,"group_name":"預り金","name":"仮受金","tax_code":2}}`,
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Fatalf("unexpected requests:\n%s\n%s", strings.Join(want, "\n"), strings.Join(requests, "\n"))
+	}
+
+	if err := client.ApplyChartSync(context.Background(), ts, &ChartSyncPlan{CompanyID: 1}); err == nil {
+		t.Fatal("plan without the target state is applied")
+	}
+}
@@ -5,12 +5,16 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"path"
+	"strings"
+	"time"
 
 	"golang.org/x/oauth2"
 )
@@ -29,6 +33,13 @@ type Config struct {
 	APIEndpoint string
 	Log         Logger
 	Oauth2      *oauth2.Config
+	// 429, 5xx レスポンスのリトライ設定（nil の場合はリトライしません）
+	Retry *RetryPolicy
+	// クライアント側のリクエスト流量制御（nil の場合は制限しません）
+	Limiter Limiter
+	// 仕訳帳の集計ステータスの確認間隔の初期値と最大値（0 の場合は既定値）
+	JournalsPollInterval    time.Duration
+	JournalsPollMaxInterval time.Duration
 }
 
 func NewConfig(clientID, clientSecret, redirectURL string) *Config {
@@ -69,6 +80,7 @@ func (c *Client) call(ctx context.Context,
 	var (
 		contentType string
 		body        io.Reader
+		companyID   string
 	)
 	if method != http.MethodDelete {
 		contentType = "application/json"
@@ -77,13 +89,18 @@ func (c *Client) call(ctx context.Context,
 			return err
 		}
 		body = bytes.NewBuffer(jsonParams)
+		companyID = companyIDFromJSON(jsonParams)
 	}
 
+	keys, err := c.limiterKeys(reuseTokenSource, queryParams, companyID)
+	if err != nil {
+		return err
+	}
 	req, err := c.newRequest(ctx, apiPath, method, contentType, queryParams, body)
 	if err != nil {
 		return err
 	}
-	return c.do(ctx, reuseTokenSource, req, res)
+	return c.do(ctx, reuseTokenSource, keys, req, res)
 }
 
 func (c *Client) postFiles(ctx context.Context,
@@ -98,30 +115,76 @@ func (c *Client) postFiles(ctx context.Context,
 		body        = &bytes.Buffer{}
 	)
 	mw := multipart.NewWriter(body)
-	fw, err := mw.CreateFormFile("receipt", fileName)
+	if err := writeMultipart(mw, postBody, fileName, "", bytes.NewReader(file)); err != nil {
+		return err
+	}
+	contentType = mw.FormDataContentType()
+
+	keys, err := c.limiterKeys(reuseTokenSource, queryParams, postBody["company_id"])
 	if err != nil {
 		return err
 	}
-	if _, err := io.Copy(fw, bytes.NewReader(file)); err != nil {
+	req, err := c.newRequest(ctx, apiPath, method, contentType, queryParams, body)
+	if err != nil {
 		return err
 	}
+	return c.do(ctx, reuseTokenSource, keys, req, res)
+}
+
+// postFileStream is the same as postFiles, but streams the file to the request
+// body without buffering it. fileContentType is sent as Content-Type of the
+// file part (application/octet-stream if empty).
+// The request is not retried because the file can not be read again.
+func (c *Client) postFileStream(ctx context.Context,
+	apiPath string, method string,
+	reuseTokenSource oauth2.TokenSource,
+	queryParams url.Values, postBody map[string]string,
+	fileName string, fileContentType string, file io.Reader,
+	res interface{},
+) error {
+	keys, err := c.limiterKeys(reuseTokenSource, queryParams, postBody["company_id"])
+	if err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		pw.CloseWithError(writeMultipart(mw, postBody, fileName, fileContentType, file))
+	}()
+
+	req, err := c.newRequest(ctx, apiPath, method, mw.FormDataContentType(), queryParams, pr)
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, reuseTokenSource, keys, req, res)
+}
+
+func writeMultipart(mw *multipart.Writer, postBody map[string]string, fileName string, fileContentType string, file io.Reader) error {
 	for k, v := range postBody {
 		if err := mw.WriteField(k, v); err != nil {
 			return err
 		}
 	}
-	contentType = mw.FormDataContentType()
-	if err := mw.Close(); err != nil {
-		return err
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="receipt"; filename="%s"`, multipartEscaper.Replace(fileName)))
+	if fileContentType == "" {
+		fileContentType = "application/octet-stream"
 	}
-
-	req, err := c.newRequest(ctx, apiPath, method, contentType, queryParams, body)
+	h.Set("Content-Type", fileContentType)
+	fw, err := mw.CreatePart(h)
 	if err != nil {
 		return err
 	}
-	return c.do(ctx, reuseTokenSource, req, res)
+	if _, err := io.Copy(fw, file); err != nil {
+		return err
+	}
+	return mw.Close()
 }
 
+var multipartEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
 func (c *Client) newRequest(
 	ctx context.Context,
 	apiPath string, method string,
@@ -150,35 +213,74 @@ func (c *Client) newRequest(
 	return req, nil
 }
 
+// do sends the request, and retries it as configured by Config.Retry.
+// Every attempt waits for the limiter with keys, so the retries are counted
+// against the same budget as the first attempt.
 func (c *Client) do(
 	ctx context.Context,
 	reuseTokenSource oauth2.TokenSource,
+	keys []string,
 	req *http.Request,
 	res interface{},
 ) error {
 	httpClient := oauth2.NewClient(ctx, reuseTokenSource)
-	response, err := httpClient.Do(req)
-	if err != nil {
-		e := &oauth2.RetrieveError{}
-		if errors.As(err, &e) {
-			resp := &Error{
-				RawError:                err.Error(),
-				IsAuthorizationRequired: true,
-			}
-			if e.Response != nil {
-				resp.StatusCode = e.Response.StatusCode
-			}
-			return resp
+	for attempt := 1; ; attempt++ {
+		if err := c.wait(ctx, keys); err != nil {
+			return err
 		}
-		errURL := &url.Error{}
-		if errors.As(err, &errURL) {
-			err = errURL.Unwrap()
-			if v, ok := err.(*Error); ok {
-				err = v
+		response, err := httpClient.Do(req)
+		if err != nil {
+			return c.handleRequestError(err)
+		}
+		if !c.config.Retry.retryable(req.Method, response.StatusCode, attempt) || !canReplay(req) {
+			return c.handleResponse(req, response, res)
+		}
+
+		wait := c.config.Retry.backoff(attempt, response.Header.Get("Retry-After"))
+		_, _ = io.Copy(ioutil.Discard, response.Body)
+		response.Body.Close()
+		c.logf("[freee] %s: %v %v%v (retry %d/%d after %v)", response.Status, req.Method, req.URL.Host, req.URL.Path, attempt, c.config.Retry.MaxAttempts-1, wait)
+		if err := sleepContext(ctx, wait); err != nil {
+			return err
+		}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return err
 			}
+			req.Body = body
 		}
-		return err
 	}
+}
+
+// canReplay reports whether the request body can be sent again.
+func canReplay(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+func (c *Client) handleRequestError(err error) error {
+	e := &oauth2.RetrieveError{}
+	if errors.As(err, &e) {
+		resp := &Error{
+			RawError:                err.Error(),
+			IsAuthorizationRequired: true,
+		}
+		if e.Response != nil {
+			resp.StatusCode = e.Response.StatusCode
+		}
+		return resp
+	}
+	errURL := &url.Error{}
+	if errors.As(err, &errURL) {
+		err = errURL.Unwrap()
+		if v, ok := err.(*Error); ok {
+			err = v
+		}
+	}
+	return err
+}
+
+func (c *Client) handleResponse(req *http.Request, response *http.Response, res interface{}) error {
 	defer response.Body.Close()
 	c.logf("[freee] %s: %s", HeaderXFreeeRequestID, response.Header.Get(HeaderXFreeeRequestID))
 	c.logf("[freee] %s: %v %v%v", response.Status, req.Method, req.URL.Host, req.URL.Path)
@@ -194,10 +296,7 @@ func (c *Client) do(
 			// error occured, but ignored.
 			c.logf("[freee] HTTP response body: %v", err)
 		}
-		res := &Error{
-			StatusCode: code,
-			RawError:   string(byt),
-		}
+		res := newError(code, byt, response.Header.Get(HeaderXFreeeRequestID))
 		// Check if re-authorization is required
 		if code == http.StatusUnauthorized {
 			var e UnauthorizedError
@@ -216,5 +315,10 @@ func (c *Client) do(
 	if res == nil {
 		return nil
 	}
+	// Stream file downloads as is
+	if w, ok := res.(io.Writer); ok {
+		_, err := io.Copy(w, r)
+		return err
+	}
 	return json.NewDecoder(r).Decode(&res)
 }
@@ -0,0 +1,156 @@
+package freee
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*Client, oauth2.TokenSource) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	conf := NewConfig("id", "secret", "")
+	conf.APIEndpoint = server.URL
+	return NewClient(conf), oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"})
+}
+
+// endpointTest is a request expected to be sent by call, and the response to it.
+type endpointTest struct {
+	name   string
+	call   func(ctx context.Context, c *Client, ts oauth2.TokenSource) error
+	method string
+	path   string
+	// クエリ文字列（空の場合はクエリなし）
+	query string
+	// JSON のリクエストボディ（空の場合はボディなし）
+	body     string
+	response string
+}
+
+// runEndpointTests checks the method, path, query and JSON body of the request
+// sent by each test, and that the response is decoded without error.
+func runEndpointTests(t *testing.T, tests []endpointTest) {
+	t.Helper()
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tt.method || r.URL.Path != tt.path || r.URL.RawQuery != tt.query {
+					t.Errorf("unexpected request: %s %s?%s", r.Method, r.URL.Path, r.URL.RawQuery)
+				}
+				b, _ := ioutil.ReadAll(r.Body)
+				if !jsonEqual(t, tt.body, string(b)) {
+					t.Errorf("unexpected body: %s", b)
+				}
+				if tt.response == "" {
+					w.WriteHeader(http.StatusNoContent)
+					return
+				}
+				fmt.Fprint(w, tt.response)
+			})
+			if err := tt.call(context.Background(), client, ts); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func jsonEqual(t *testing.T, want, got string) bool {
+	t.Helper()
+	// ボディのないリクエストは null を送る
+	if got == "null" {
+		got = ""
+	}
+	if want == "" || got == "" {
+		return want == got
+	}
+	var w, g interface{}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(got), &g); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(w, g)
+}
+
+func TestClientRetry(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		method    string
+		retryPost bool
+		failures  int32
+		wantCalls int32
+		wantErr   bool
+	}{
+		{"get recovers", http.MethodGet, false, 2, 3, false},
+		{"get exhausted", http.MethodGet, false, 5, 3, true},
+		{"post not retried", http.MethodPost, false, 1, 1, true},
+		{"post opted in", http.MethodPost, true, 1, 2, false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var calls int32
+			client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&calls, 1)
+				if r.Method == http.MethodPost {
+					body, _ := ioutil.ReadAll(r.Body)
+					if string(body) != `{"name":"x"}` {
+						t.Errorf("unexpected body: %s", body)
+					}
+				}
+				if n <= tt.failures {
+					if n%2 == 0 {
+						w.Header().Set("Retry-After", "0")
+						w.WriteHeader(http.StatusTooManyRequests)
+						return
+					}
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				fmt.Fprint(w, `{"item":{"id":1}}`)
+			})
+			client.config.Retry = &RetryPolicy{
+				MaxAttempts:    3,
+				InitialBackoff: time.Millisecond,
+				MaxBackoff:     10 * time.Millisecond,
+				RetryPost:      tt.retryPost,
+			}
+			var result ItemResponse
+			err := client.call(context.Background(), APIPathItems, tt.method, ts, nil, map[string]string{"name": "x"}, &result)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if calls != tt.wantCalls {
+				t.Fatalf("unmatch call nums : %d, %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+	if d, ok := parseRetryAfter("120"); !ok || d != 120*time.Second {
+		t.Fatalf("unexpected duration: %v, %v", d, ok)
+	}
+	if _, ok := parseRetryAfter(""); ok {
+		t.Fatal("parsed empty value")
+	}
+	if d, ok := parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)); !ok || d != 0 {
+		t.Fatalf("unexpected duration: %v, %v", d, ok)
+	}
+}
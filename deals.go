@@ -12,7 +12,9 @@ import (
 )
 
 const (
-	APIPathDeals = "deals"
+	APIPathDeals        = "deals"
+	APIPathDealPayments = "payments"
+	APIPathDealRenews   = "renews"
 
 	DealTypeIncome            = "income"
 	DealTypeExpense           = "expense"
@@ -20,6 +22,9 @@ const (
 	DealStatusUnsettled       = "unsettled"
 	DealDetailEntrySideCredit = "credit"
 	DealDetailEntrySideDebit  = "debit"
+
+	// DealMaxDetails is the max number of the details in a deal.
+	DealMaxDetails = 100
 )
 
 type DealsResponse struct {
@@ -76,9 +81,9 @@ type Deal struct {
 	// 支払期日 (yyyy-mm-dd)
 	DueDate *string `json:"due_date,omitempty"`
 	// 金額
-	Amount int32 `json:"amount"`
+	Amount int64 `json:"amount"`
 	// 支払金額
-	DueAmount *int32 `json:"due_amount,omitempty"`
+	DueAmount *int64 `json:"due_amount,omitempty"`
 	// 収支区分 (収入: income, 支出: expense)
 	Type *string `json:"type,omitempty"`
 	// 取引先ID
@@ -118,9 +123,9 @@ type DealDetails struct {
 	// セグメント３ID
 	Segment3TagID *int32 `json:"segment_3_tag_id,omitempty"`
 	// 取引金額（税込で指定してください）
-	Amount int32 `json:"amount"`
+	Amount int64 `json:"amount"`
 	// 消費税額（指定しない場合は自動で計算されます）
-	Vat int32 `json:"vat"`
+	Vat int64 `json:"vat"`
 	// 備考
 	Description *string `json:"description,omitempty"`
 	// 貸借（貸方: credit, 借方: debit）
@@ -133,7 +138,7 @@ type DealRenews struct {
 	// 更新日 (yyyy-mm-dd)
 	UpdateDate string `json:"update_date"`
 	// +更新の対象行ID
-	RenewTargetId int64 `json:"renew_target_id"`
+	RenewTargetID uint64 `json:"renew_target_id"`
 	// +更新の対象行タイプ
 	RenewTargetType string `json:"renew_target_type"`
 	// +更新の明細行一覧（配列）
@@ -150,7 +155,7 @@ type DealPayments struct {
 	// 口座ID（from_walletable_typeがprivate_account_itemの場合は勘定科目ID）
 	FromWalletableID *int32 `json:"from_walletable_id,omitempty"`
 	// 支払金額
-	Amount int32 `json:"amount"`
+	Amount int64 `json:"amount"`
 }
 
 type DealReceipts struct {
@@ -208,7 +213,7 @@ type DealCreateParamsDetails struct {
 	// 勘定科目ID
 	AccountItemID int32 `json:"account_item_id"`
 	// 取引金額（税込で指定してください）
-	Amount int32 `json:"amount"`
+	Amount int64 `json:"amount"`
 	// 品目ID
 	ItemID *int32 `json:"item_id,omitempty"`
 	// 部門ID
@@ -224,12 +229,12 @@ type DealCreateParamsDetails struct {
 	// 備考
 	Description *string `json:"description,omitempty"`
 	// 消費税額（指定しない場合は自動で計算されます）
-	Vat *int32 `json:"vat,omitempty"`
+	Vat *int64 `json:"vat,omitempty"`
 }
 
 type DealCreateParamsPayments struct {
 	// 支払金額：payments指定時は必須
-	Amount int32 `json:"amount"`
+	Amount int64 `json:"amount"`
 	// 口座ID（from_walletable_typeがprivate_account_itemの場合は勘定科目ID）：payments指定時は必須
 	FromWalletableID int32 `json:"from_walletable_id"`
 	// 口座区分 (銀行口座: bank_account, クレジットカード: credit_card, 現金: wallet, プライベート資金（法人の場合は役員借入金もしくは役員借入金、個人の場合は事業主貸もしくは事業主借）: private_account_item)：payments指定時は必須
@@ -266,7 +271,67 @@ type DealUpdateParamsDetails struct {
 	// 勘定科目ID
 	AccountItemID int32 `json:"account_item_id"`
 	// 取引金額（税込で指定してください）
-	Amount int32 `json:"amount"`
+	Amount int64 `json:"amount"`
+	// 品目ID
+	ItemID *int32 `json:"item_id,omitempty"`
+	// 部門ID
+	SectionID *int32 `json:"section_id,omitempty"`
+	// メモタグID
+	TagIDs *[]int32 `json:"tag_ids,omitempty"`
+	// セグメント１ID
+	Segment1TagID *int32 `json:"segment_1_tag_id,omitempty"`
+	// セグメント２ID
+	Segment2TagID *int32 `json:"segment_2_tag_id,omitempty"`
+	// セグメント３ID
+	Segment3TagID *int32 `json:"segment_3_tag_id,omitempty"`
+	// 備考
+	Description *string `json:"description,omitempty"`
+	// 消費税額（指定しない場合は自動で計算されます）
+	Vat *int64 `json:"vat,omitempty"`
+}
+
+type DealPaymentParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// 支払日
+	Date string `json:"date"`
+	// 口座区分 (銀行口座: bank_account, クレジットカード: credit_card, 現金: wallet, プライベート資金（法人の場合は役員借入金もしくは役員借入金、個人の場合は事業主貸もしくは事業主借）: private_account_item)
+	FromWalletableType string `json:"from_walletable_type"`
+	// 口座ID（from_walletable_typeがprivate_account_itemの場合は勘定科目ID）
+	FromWalletableID int32 `json:"from_walletable_id"`
+	// 支払金額
+	Amount int64 `json:"amount"`
+}
+
+type DealRenewCreateParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// +更新日 (yyyy-mm-dd)
+	UpdateDate string `json:"update_date"`
+	// +更新対象の取引行ID（取引の明細行・+更新行の明細行）
+	RenewTargetID uint64 `json:"renew_target_id"`
+	// +更新の明細行一覧（配列）
+	Details []DealRenewParamsDetails `json:"details"`
+}
+
+type DealRenewUpdateParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// +更新日 (yyyy-mm-dd)
+	UpdateDate string `json:"update_date"`
+	// +更新の明細行一覧（配列）
+	Details []DealRenewParamsDetails `json:"details"`
+}
+
+type DealRenewParamsDetails struct {
+	// +更新の明細行ID: 既存の明細行を更新する場合に指定します。IDを指定しない明細行は、新規行として扱われ追加されます。
+	ID *uint64 `json:"id,omitempty"`
+	// 税区分コード
+	TaxCode int32 `json:"tax_code"`
+	// 勘定科目ID
+	AccountItemID int32 `json:"account_item_id"`
+	// 取引金額（税込で指定してください）
+	Amount int64 `json:"amount"`
 	// 品目ID
 	ItemID *int32 `json:"item_id,omitempty"`
 	// 部門ID
@@ -282,7 +347,7 @@ type DealUpdateParamsDetails struct {
 	// 備考
 	Description *string `json:"description,omitempty"`
 	// 消費税額（指定しない場合は自動で計算されます）
-	Vat *int32 `json:"vat,omitempty"`
+	Vat *int64 `json:"vat,omitempty"`
 }
 
 func (c *Client) GetDeals(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*DealsResponse, error) {
@@ -301,7 +366,25 @@ func (c *Client) GetDeals(ctx context.Context, reuseTokenSource oauth2.TokenSour
 	return &result, nil
 }
 
-func (c *Client) GetDeal(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, dealID int32, opts interface{}) (*Deal, error) {
+func (c *Client) GetAllDeals(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetDealOpts) ([]Deal, error) {
+	var deals []Deal
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitDeals, &deals, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetDeals(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Deals, result.Meta.TotalCount, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deals, nil
+}
+
+func (c *Client) GetDeal(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, dealID uint64, opts interface{}) (*Deal, error) {
 	var result DealResponse
 
 	v, err := query.Values(opts)
@@ -325,7 +408,7 @@ func (c *Client) CreateDeal(ctx context.Context, reuseTokenSource oauth2.TokenSo
 	return &result.Deal, nil
 }
 
-func (c *Client) UpdateDeal(ctx context.Context, reuseTokenSource oauth2.TokenSource, dealID int32, params DealUpdateParams) (*Deal, error) {
+func (c *Client) UpdateDeal(ctx context.Context, reuseTokenSource oauth2.TokenSource, dealID uint64, params DealUpdateParams) (*Deal, error) {
 	var result DealResponse
 	err := c.call(ctx, path.Join(APIPathDeals, fmt.Sprint(dealID)), http.MethodPut, reuseTokenSource, nil, params, &result)
 	if err != nil {
@@ -334,7 +417,7 @@ func (c *Client) UpdateDeal(ctx context.Context, reuseTokenSource oauth2.TokenSo
 	return &result.Deal, nil
 }
 
-func (c *Client) DestroyDeal(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, dealID int32) error {
+func (c *Client) DestroyDeal(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, dealID uint64) error {
 	v, err := query.Values(nil)
 	if err != nil {
 		return err
@@ -348,6 +431,77 @@ func (c *Client) DestroyDeal(ctx context.Context, reuseTokenSource oauth2.TokenS
 	return nil
 }
 
+// CreateDealPayment adds a payment row to the deal.
+func (c *Client) CreateDealPayment(ctx context.Context, reuseTokenSource oauth2.TokenSource, dealID uint64, params DealPaymentParams) (*Deal, error) {
+	var result DealResponse
+	err := c.call(ctx, path.Join(APIPathDeals, fmt.Sprint(dealID), APIPathDealPayments), http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Deal, nil
+}
+
+// UpdateDealPayment updates a payment row of the deal.
+func (c *Client) UpdateDealPayment(ctx context.Context, reuseTokenSource oauth2.TokenSource, dealID uint64, paymentID uint64, params DealPaymentParams) (*Deal, error) {
+	var result DealResponse
+	err := c.call(ctx, path.Join(APIPathDeals, fmt.Sprint(dealID), APIPathDealPayments, fmt.Sprint(paymentID)), http.MethodPut, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Deal, nil
+}
+
+// DestroyDealPayment deletes a payment row of the deal.
+func (c *Client) DestroyDealPayment(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, dealID uint64, paymentID uint64) error {
+	v, err := query.Values(nil)
+	if err != nil {
+		return err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathDeals, fmt.Sprint(dealID), APIPathDealPayments, fmt.Sprint(paymentID)), http.MethodDelete, reuseTokenSource, v, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateDealRenew adds a +更新 row to the deal.
+func (c *Client) CreateDealRenew(ctx context.Context, reuseTokenSource oauth2.TokenSource, dealID uint64, params DealRenewCreateParams) (*Deal, error) {
+	var result DealResponse
+	err := c.call(ctx, path.Join(APIPathDeals, fmt.Sprint(dealID), APIPathDealRenews), http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Deal, nil
+}
+
+// UpdateDealRenew updates a +更新 row of the deal.
+func (c *Client) UpdateDealRenew(ctx context.Context, reuseTokenSource oauth2.TokenSource, dealID uint64, renewID uint64, params DealRenewUpdateParams) (*Deal, error) {
+	var result DealResponse
+	err := c.call(ctx, path.Join(APIPathDeals, fmt.Sprint(dealID), APIPathDealRenews, fmt.Sprint(renewID)), http.MethodPut, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Deal, nil
+}
+
+// DestroyDealRenew deletes a +更新 row of the deal, and returns the deal after deletion.
+func (c *Client) DestroyDealRenew(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, dealID uint64, renewID uint64) (*Deal, error) {
+	var result DealResponse
+
+	v, err := query.Values(nil)
+	if err != nil {
+		return nil, err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathDeals, fmt.Sprint(dealID), APIPathDealRenews, fmt.Sprint(renewID)), http.MethodDelete, reuseTokenSource, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Deal, nil
+}
+
 func (s *Client) GetDealOrderList() []string {
 	str := new(Deal)
 
@@ -0,0 +1,74 @@
+package freee
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestDealPaymentsAndRenews(t *testing.T) {
+	t.Parallel()
+	deal := `{"deal":{"id":4000000000,"company_id":1}}`
+	payment := DealPaymentParams{CompanyID: 1, Date: "2021-06-30", FromWalletableType: WalletTypeBankAccount, FromWalletableID: 2, Amount: 1100}
+	paymentBody := `{"company_id":1,"date":"2021-06-30","from_walletable_type":"bank_account","from_walletable_id":2,"amount":1100}`
+	details := []DealRenewParamsDetails{{TaxCode: 2, AccountItemID: 3, Amount: 1100}}
+
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "create payment",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.CreateDealPayment(ctx, ts, 4000000000, payment)
+				return err
+			},
+			method: http.MethodPost, path: "/api/1/deals/4000000000/payments",
+			body: paymentBody, response: deal,
+		},
+		{
+			name: "update payment",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.UpdateDealPayment(ctx, ts, 4000000000, 5000000000, payment)
+				return err
+			},
+			method: http.MethodPut, path: "/api/1/deals/4000000000/payments/5000000000",
+			body: paymentBody, response: deal,
+		},
+		{
+			name: "destroy payment",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				return c.DestroyDealPayment(ctx, ts, 1, 4000000000, 5000000000)
+			},
+			method: http.MethodDelete, path: "/api/1/deals/4000000000/payments/5000000000", query: "company_id=1",
+		},
+		{
+			name: "create renew",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.CreateDealRenew(ctx, ts, 4000000000, DealRenewCreateParams{CompanyID: 1, UpdateDate: "2021-07-01", RenewTargetID: 6000000000, Details: details})
+				return err
+			},
+			method: http.MethodPost, path: "/api/1/deals/4000000000/renews",
+			body:     `{"company_id":1,"update_date":"2021-07-01","renew_target_id":6000000000,"details":[{"tax_code":2,"account_item_id":3,"amount":1100}]}`,
+			response: deal,
+		},
+		{
+			name: "update renew",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.UpdateDealRenew(ctx, ts, 4000000000, 7000000000, DealRenewUpdateParams{CompanyID: 1, UpdateDate: "2021-07-01", Details: details})
+				return err
+			},
+			method: http.MethodPut, path: "/api/1/deals/4000000000/renews/7000000000",
+			body:     `{"company_id":1,"update_date":"2021-07-01","details":[{"tax_code":2,"account_item_id":3,"amount":1100}]}`,
+			response: deal,
+		},
+		{
+			name: "destroy renew",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.DestroyDealRenew(ctx, ts, 1, 4000000000, 7000000000)
+				return err
+			},
+			method: http.MethodDelete, path: "/api/1/deals/4000000000/renews/7000000000", query: "company_id=1",
+			response: deal,
+		},
+	})
+}
@@ -1,6 +1,10 @@
 package freee
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+)
 
 const (
 	UnauthorizedCodeInvalidAccessToken      = "invalid_access_token"
@@ -11,6 +15,33 @@ const (
 	UnauthorizedCodeSourceIPAddressLimit    = "source_ip_address_limit"
 )
 
+const (
+	ErrorTypeStatus     = "status"
+	ErrorTypeValidation = "validation"
+	ErrorTypeError      = "error"
+
+	// 月締めされた期間の取引を登録・更新しようとした場合のエラーメッセージ
+	errorMessageMonthClosed = "月締め"
+)
+
+// ErrorKind is a category of freee API errors.
+// It can be used as a target of errors.Is.
+type ErrorKind string
+
+func (k ErrorKind) Error() string {
+	return "freee: " + string(k)
+}
+
+const (
+	ErrNotFound         ErrorKind = "not found"
+	ErrValidation       ErrorKind = "validation failed"
+	ErrPermissionDenied ErrorKind = "permission denied"
+	ErrPlanLimit        ErrorKind = "plan limit"
+	ErrIPRestricted     ErrorKind = "source ip address restricted"
+	ErrMonthClosed      ErrorKind = "month closed"
+	ErrRateLimited      ErrorKind = "rate limited"
+)
+
 type UnauthorizedError struct {
 	Message string `json:"message"`
 	Code    string `json:"code"`
@@ -20,23 +51,102 @@ type Error struct {
 	StatusCode              int
 	RawError                string
 	IsAuthorizationRequired bool
+	// X-Freee-Request-ID（問い合わせ時に必要）
+	RequestID string
+	// エラーコード（user_do_not_have_permission など）
+	Codes []string
+	// エラーの分類
+	Kinds []ErrorKind
 }
 
 func (e *Error) Error() string {
 	return e.RawError
 }
 
+// Is reports whether e is classified as target, which must be an ErrorKind.
+func (e *Error) Is(target error) bool {
+	kind, ok := target.(ErrorKind)
+	if !ok {
+		return false
+	}
+	for _, k := range e.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// newError builds an Error from a freee API error response.
+func newError(statusCode int, body []byte, requestID string) *Error {
+	e := &Error{
+		StatusCode: statusCode,
+		RawError:   string(body),
+		RequestID:  requestID,
+	}
+
+	var errorMessage FreeeErrorMessage
+	if err := json.Unmarshal(body, &errorMessage); err == nil {
+		if errorMessage.Code != "" {
+			e.Codes = append(e.Codes, errorMessage.Code)
+		}
+		for _, detail := range errorMessage.ErrorDetails {
+			e.Codes = append(e.Codes, detail.Codes...)
+		}
+	}
+
+	addKind := func(kind ErrorKind) {
+		if !e.Is(kind) {
+			e.Kinds = append(e.Kinds, kind)
+		}
+	}
+	switch statusCode {
+	case http.StatusNotFound:
+		addKind(ErrNotFound)
+	case http.StatusForbidden:
+		addKind(ErrPermissionDenied)
+	case http.StatusTooManyRequests:
+		addKind(ErrRateLimited)
+	}
+	for _, code := range e.Codes {
+		switch code {
+		case UnauthorizedCodeUserDoNotHavePermission:
+			addKind(ErrPermissionDenied)
+		case UnauthorizedCodeCompanyNotFound:
+			addKind(ErrNotFound)
+		case UnauthorizedCodeFreeePlanLimit:
+			addKind(ErrPlanLimit)
+		case UnauthorizedCodeSourceIPAddressLimit:
+			addKind(ErrIPRestricted)
+		}
+	}
+	for _, detail := range errorMessage.ErrorDetails {
+		if detail.Type == ErrorTypeValidation && statusCode == http.StatusBadRequest {
+			addKind(ErrValidation)
+		}
+		for _, msg := range detail.Messages {
+			if strings.Contains(msg, errorMessageMonthClosed) {
+				addKind(ErrMonthClosed)
+			}
+		}
+	}
+	return e
+}
+
 func (e *Error) Messages() []string {
 	messages, _ := ExtractFreeeErrorMessage(e.RawError)
 	return messages
 }
 
 type FreeErrorMessageDetail struct {
+	Type     string   `json:"type"`
 	Messages []string `json:"messages"`
+	Codes    []string `json:"codes"`
 }
 type FreeeErrorMessage struct {
 	ErrorDescription string                   `json:"error_description"`
 	Message          string                   `json:"message"`
+	Code             string                   `json:"code"`
 	Messages         []string                 `json:"messages"`
 	ErrorDetails     []FreeErrorMessageDetail `json:"errors"`
 }
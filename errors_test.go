@@ -1,6 +1,7 @@
 package freee
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 )
@@ -122,3 +123,48 @@ func TestInvalidErrorMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestNewError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		statusCode int
+		body       string
+		kinds      []ErrorKind
+		codes      int
+	}{
+		{404, "{\"status_code\":404,\"errors\":[{\"type\":\"status\",\"messages\":[\"リソースが見つかりません。\"]},{\"type\":\"validation\",\"messages\":[\"既に削除された、あるいは存在しない取引先です。\"]}]}", []ErrorKind{ErrNotFound}, 0},
+		{400, "{\"status_code\":400,\"errors\":[{\"type\":\"status\",\"messages\":[\"不正なリクエストです。\"]},{\"type\":\"validation\",\"messages\":[\"指定された partner_id は存在しません。\"]}]}", []ErrorKind{ErrValidation}, 0},
+		{400, "{\"status_code\":400,\"errors\":[{\"type\":\"status\",\"messages\":[\"不正なリクエストです。\"]},{\"type\":\"validation\",\"messages\":[\"月締めがされています。2021-06-01以降の日付を入力してください。\"]}]}", []ErrorKind{ErrValidation, ErrMonthClosed}, 0},
+		{401, "{\"status_code\":401,\"errors\":[{\"type\":\"status\",\"messages\":[\"アクセス権限がありません。\"],\"codes\":[\"user_do_not_have_permission\"]}]}", []ErrorKind{ErrPermissionDenied}, 1},
+		{403, "{\"status_code\":403,\"errors\":[{\"type\":\"status\",\"messages\":[\"ご契約のプランではご利用いただけません。\"],\"codes\":[\"freee_plan_limit\"]}]}", []ErrorKind{ErrPermissionDenied, ErrPlanLimit}, 1},
+		{403, "{\"message\":\"許可されていないIPアドレスからのアクセスです。\",\"code\":\"source_ip_address_limit\"}", []ErrorKind{ErrPermissionDenied, ErrIPRestricted}, 1},
+		{429, "", []ErrorKind{ErrRateLimited}, 0},
+		{500, "aaaaaaaa", nil, 0},
+	}
+	for i, tt := range tests {
+		tt := tt
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			t.Parallel()
+			e := newError(tt.statusCode, []byte(tt.body), "request-id")
+			if e.RequestID != "request-id" {
+				t.Fatalf("unexpected request id: %s", e.RequestID)
+			}
+			if len(e.Codes) != tt.codes {
+				t.Fatalf("unmatch code nums : %d, %d", tt.codes, len(e.Codes))
+			}
+			if len(e.Kinds) != len(tt.kinds) {
+				t.Fatalf("unexpected kinds: %v", e.Kinds)
+			}
+			var err error = fmt.Errorf("wrapped: %w", e)
+			for _, kind := range tt.kinds {
+				if !errors.Is(err, kind) {
+					t.Fatalf("not %v: %v", kind, e.Kinds)
+				}
+			}
+			var freeeErr *Error
+			if !errors.As(err, &freeeErr) || freeeErr.StatusCode != tt.statusCode {
+				t.Fatal("not *Error")
+			}
+		})
+	}
+}
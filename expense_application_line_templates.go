@@ -43,6 +43,21 @@ type ExpenseApplicationLineTemplate struct {
 	RequiredReceipt *bool `json:"required_receipt,omitempty"`
 }
 
+// Line returns the params of an expense application line with the template.
+func (t *ExpenseApplicationLineTemplate) Line(transactionDate string, amount int64) ExpenseApplicationLineParams {
+	id := t.ID
+	line := ExpenseApplicationLineParams{
+		TransactionDate:                  &transactionDate,
+		Amount:                           amount,
+		ExpenseApplicationLineTemplateID: &id,
+	}
+	if t.LineDescription != nil {
+		description := *t.LineDescription
+		line.Description = &description
+	}
+	return line
+}
+
 func (c *Client) GetExpenseApplicationLineTemplates(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*ExpenseApplicationLineTemplates, error) {
 	var result ExpenseApplicationLineTemplates
 
@@ -59,6 +74,24 @@ func (c *Client) GetExpenseApplicationLineTemplates(ctx context.Context, reuseTo
 	return &result, nil
 }
 
+func (c *Client) GetAllExpenseApplicationLineTemplates(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetExpenseApplicationLineTemplatesOpts) ([]ExpenseApplicationLineTemplate, error) {
+	var templates []ExpenseApplicationLineTemplate
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitExpenseApplicationLineTemplates, &templates, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetExpenseApplicationLineTemplates(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.ExpenseApplicationLineTemplates, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
 func (s *Client) GetExpenseApplicationLineTemplateOrderList() []string {
 	str := new(ExpenseApplicationLineTemplate)
 
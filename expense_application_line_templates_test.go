@@ -0,0 +1,24 @@
+package freee
+
+import "testing"
+
+func TestExpenseApplicationLineTemplateLine(t *testing.T) {
+	t.Parallel()
+	template := &ExpenseApplicationLineTemplate{ID: 7, Name: "交通費", LineDescription: stringPtr("移動区間")}
+	line := template.Line("2021-05-31", 1200)
+	if *line.TransactionDate != "2021-05-31" || line.Amount != 1200 || *line.ExpenseApplicationLineTemplateID != 7 || *line.Description != "移動区間" || line.ReceiptID != nil {
+		t.Fatalf("unexpected line: %+v", line)
+	}
+
+	// 行の変更はテンプレートに影響しない
+	*line.Description = "東京-大阪"
+	*line.ExpenseApplicationLineTemplateID = 8
+	if *template.LineDescription != "移動区間" || template.ID != 7 {
+		t.Fatalf("template is modified by line: %+v", template)
+	}
+
+	line = (&ExpenseApplicationLineTemplate{ID: 9}).Line("2021-05-31", 500)
+	if line.Description != nil {
+		t.Fatalf("unexpected description: %s", *line.Description)
+	}
+}
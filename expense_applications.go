@@ -2,7 +2,9 @@ package freee
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"path"
 	"reflect"
 
 	"github.com/google/go-querystring/query"
@@ -24,8 +26,8 @@ type GetExpenseApplicationsOpts struct {
 	EndIssueDate         string `url:"end_issue_date,omitempty"`
 	ApplicantID          int32  `url:"applicant_id,omitempty"`
 	ApproverID           int32  `url:"approver_id,omitempty"`
-	MinAmount            int32  `url:"min_amount,omitempty"`
-	MaxAmount            int32  `url:"max_amount,omitempty"`
+	MinAmount            int64  `url:"min_amount,omitempty"`
+	MaxAmount            int64  `url:"max_amount,omitempty"`
 	Offset               int32  `url:"offset,omitempty"`
 	Limit                int32  `url:"limit,omitempty"`
 }
@@ -34,6 +36,10 @@ type ExpenseApplications struct {
 	ExpenseApplications []ExpenseApplication `json:"expense_applications"`
 }
 
+type ExpenseApplicationResponse struct {
+	ExpenseApplication ExpenseApplication `json:"expense_application"`
+}
+
 type ExpenseApplication struct {
 	// 経費申請ID
 	ID int32 `json:"id"`
@@ -46,7 +52,7 @@ type ExpenseApplication struct {
 	// 備考
 	Description *string `json:"description,omitempty"`
 	// 合計金額
-	TotalAmount *int32 `json:"total_amount,omitempty"`
+	TotalAmount *int64 `json:"total_amount,omitempty"`
 	// 申請ステータス(draft:下書き, in_progress:申請中, approved:承認済, rejected:却下, feedback:差戻し)
 	Status string `json:"status"`
 	// 部門ID
@@ -56,7 +62,7 @@ type ExpenseApplication struct {
 	// 経費申請の項目行一覧（配列）
 	ExpenseApplicationLines []ExpenseApplicationLine `json:"expense_application_lines"`
 	// 取引ID (申請ステータス:statusがapprovedで、取引が存在する時のみdeal_idが表示されます)
-	DealID int32 `json:"deal_id"`
+	DealID uint64 `json:"deal_id"`
 	// 取引ステータス (申請ステータス:statusがapprovedで、取引が存在する時のみdeal_statusが表示されます settled:精算済み, unsettled:清算待ち)
 	DealStatus string `json:"deal_status"`
 	// 申請者のユーザーID
@@ -67,6 +73,8 @@ type ExpenseApplication struct {
 	CurrentStepID *int32 `json:"current_step_id,omitempty"`
 	// 現在のround。差し戻し等により申請がstepの最初からやり直しになるとroundの値が増えます。
 	CurrentRound *int32 `json:"current_round,omitempty"`
+	// 承認者（配列）(経費申請の取得時のみ含まれる)
+	Approvers *[]Approver `json:"approvers,omitempty"`
 	// セグメント１ID
 	Segment1TagID *int32 `json:"segment_1_tag_id,omitempty"`
 	// セグメント２ID
@@ -83,7 +91,49 @@ type ExpenseApplicationLine struct {
 	// 内容
 	Description *string `json:"description,omitempty"`
 	// 金額
-	Amount *int32 `json:"amount,omitempty"`
+	Amount *int64 `json:"amount,omitempty"`
+	// 経費科目ID
+	ExpenseApplicationLineTemplateID *int32 `json:"expense_application_line_template_id,omitempty"`
+	// 証憑ファイルID（ファイルボックスのファイルID）
+	ReceiptID *int32 `json:"receipt_id,omitempty"`
+}
+
+type ExpenseApplicationParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// 申請タイトル (250文字以内)
+	Title string `json:"title"`
+	// 申請日 (yyyy-mm-dd)
+	IssueDate string `json:"issue_date"`
+	// 備考 (10000文字以内)
+	Description *string `json:"description,omitempty"`
+	// 部門ID
+	SectionID *int32 `json:"section_id,omitempty"`
+	// メモタグID
+	TagIDs *[]int32 `json:"tag_ids,omitempty"`
+	// 経費申請の項目行一覧（配列）
+	ExpenseApplicationLines []ExpenseApplicationLineParams `json:"expense_application_lines"`
+	// true: 下書き、false: 申請
+	Draft *bool `json:"draft,omitempty"`
+	// 申請経路ID
+	ApprovalFlowRouteID *int32 `json:"approval_flow_route_id,omitempty"`
+	// 承認者のユーザーID (申請時、経路の最初のステップの承認方法が申請時に決定する場合は必須)
+	ApproverID *int32 `json:"approver_id,omitempty"`
+	// セグメント１ID
+	Segment1TagID *int32 `json:"segment_1_tag_id,omitempty"`
+	// セグメント２ID
+	Segment2TagID *int32 `json:"segment_2_tag_id,omitempty"`
+	// セグメント３ID
+	Segment3TagID *int32 `json:"segment_3_tag_id,omitempty"`
+}
+
+type ExpenseApplicationLineParams struct {
+	// 日付 (yyyy-mm-dd)
+	TransactionDate *string `json:"transaction_date,omitempty"`
+	// 内容 (250文字以内)
+	Description *string `json:"description,omitempty"`
+	// 金額
+	Amount int64 `json:"amount"`
 	// 経費科目ID
 	ExpenseApplicationLineTemplateID *int32 `json:"expense_application_line_template_id,omitempty"`
 	// 証憑ファイルID（ファイルボックスのファイルID）
@@ -106,6 +156,94 @@ func (c *Client) GetExpenseApplications(ctx context.Context, reuseTokenSource oa
 	return &result, nil
 }
 
+func (c *Client) GetAllExpenseApplications(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetExpenseApplicationsOpts) ([]ExpenseApplication, error) {
+	var applications []ExpenseApplication
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitExpenseApplications, &applications, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetExpenseApplications(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.ExpenseApplications, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return applications, nil
+}
+
+func (c *Client) GetExpenseApplication(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, expenseApplicationID int32) (*ExpenseApplication, error) {
+	var result ExpenseApplicationResponse
+
+	v, err := query.Values(nil)
+	if err != nil {
+		return nil, err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathExpenseApplications, fmt.Sprint(expenseApplicationID)), http.MethodGet, reuseTokenSource, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.ExpenseApplication, nil
+}
+
+func (c *Client) CreateExpenseApplication(ctx context.Context, reuseTokenSource oauth2.TokenSource, params ExpenseApplicationParams) (*ExpenseApplication, error) {
+	var result ExpenseApplicationResponse
+	err := c.call(ctx, APIPathExpenseApplications, http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.ExpenseApplication, nil
+}
+
+func (c *Client) UpdateExpenseApplication(ctx context.Context, reuseTokenSource oauth2.TokenSource, expenseApplicationID int32, params ExpenseApplicationParams) (*ExpenseApplication, error) {
+	var result ExpenseApplicationResponse
+	err := c.call(ctx, path.Join(APIPathExpenseApplications, fmt.Sprint(expenseApplicationID)), http.MethodPut, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.ExpenseApplication, nil
+}
+
+func (c *Client) DestroyExpenseApplication(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, expenseApplicationID int32) error {
+	v, err := query.Values(nil)
+	if err != nil {
+		return err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathExpenseApplications, fmt.Sprint(expenseApplicationID)), http.MethodDelete, reuseTokenSource, v, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ActionExpenseApplication approves, rejects, sends back or withdraws (cancel) the expense application.
+func (c *Client) ActionExpenseApplication(ctx context.Context, reuseTokenSource oauth2.TokenSource, expenseApplicationID int32, params ApprovalActionParams) (*ExpenseApplication, error) {
+	var result ExpenseApplicationResponse
+	err := c.call(ctx, path.Join(APIPathExpenseApplications, fmt.Sprint(expenseApplicationID), APIPathActions), http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.ExpenseApplication, nil
+}
+
+// ActionParams returns the params of the approval action on the current step and round.
+func (e *ExpenseApplication) ActionParams(approvalAction string) (*ApprovalActionParams, error) {
+	if e.CurrentStepID == nil || e.CurrentRound == nil {
+		return nil, fmt.Errorf("expense application %d is not in progress", e.ID)
+	}
+	return &ApprovalActionParams{
+		CompanyID:      e.CompanyID,
+		ApprovalAction: approvalAction,
+		TargetStepID:   *e.CurrentStepID,
+		TargetRound:    *e.CurrentRound,
+	}, nil
+}
+
 func (s *Client) GetExpenseApplicationOrderList() []string {
 	str := new(ExpenseApplication)
 
@@ -0,0 +1,82 @@
+package freee
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestExpenseApplicationEndpoints(t *testing.T) {
+	t.Parallel()
+	application := `{"expense_application":{"id":10,"company_id":1,"title":"交通費","issue_date":"2021-06-01","status":"in_progress",
+		"expense_application_lines":[],"applicant_id":2,"current_step_id":5,"current_round":1}}`
+	template := &ExpenseApplicationLineTemplate{ID: 7, LineDescription: stringPtr("移動区間")}
+	params := ExpenseApplicationParams{
+		CompanyID:               1,
+		Title:                   "交通費",
+		IssueDate:               "2021-06-01",
+		ExpenseApplicationLines: []ExpenseApplicationLineParams{template.Line("2021-05-31", 1200)},
+		Draft:                   func(b bool) *bool { return &b }(false),
+	}
+	body := `{"company_id":1,"title":"交通費","issue_date":"2021-06-01","expense_application_lines":[
+		{"transaction_date":"2021-05-31","description":"移動区間","amount":1200,"expense_application_line_template_id":7}],"draft":false}`
+
+	stepID, round := int32(5), int32(1)
+	current := &ExpenseApplication{ID: 10, CompanyID: 1, CurrentStepID: &stepID, CurrentRound: &round}
+	action, err := current.ActionParams(ApprovalActionApprove)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&ExpenseApplication{ID: 11}).ActionParams(ApprovalActionApprove); err == nil {
+		t.Fatal("action on the application which is not in progress is accepted")
+	}
+
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "get",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.GetExpenseApplication(ctx, ts, 1, 10)
+				return err
+			},
+			method: http.MethodGet, path: "/api/1/expense_applications/10", query: "company_id=1",
+			response: application,
+		},
+		{
+			name: "create",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.CreateExpenseApplication(ctx, ts, params)
+				return err
+			},
+			method: http.MethodPost, path: "/api/1/expense_applications",
+			body: body, response: application,
+		},
+		{
+			name: "update",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.UpdateExpenseApplication(ctx, ts, 10, params)
+				return err
+			},
+			method: http.MethodPut, path: "/api/1/expense_applications/10",
+			body: body, response: application,
+		},
+		{
+			name: "destroy",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				return c.DestroyExpenseApplication(ctx, ts, 1, 10)
+			},
+			method: http.MethodDelete, path: "/api/1/expense_applications/10", query: "company_id=1",
+		},
+		{
+			name: "action",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.ActionExpenseApplication(ctx, ts, 10, *action)
+				return err
+			},
+			method: http.MethodPost, path: "/api/1/expense_applications/10/actions",
+			body:     `{"company_id":1,"approval_action":"approve","target_step_id":5,"target_round":1}`,
+			response: application,
+		},
+	})
+}
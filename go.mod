@@ -5,4 +5,6 @@ go 1.15
 require (
 	github.com/google/go-querystring v1.1.0
 	golang.org/x/oauth2 v0.0.0-20210427180440-81ed05c6b58c
+	golang.org/x/text v0.3.6
+	gopkg.in/yaml.v2 v2.4.0
 )
@@ -2,7 +2,9 @@ package freee
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"path"
 	"reflect"
 
 	"github.com/google/go-querystring/query"
@@ -11,6 +13,19 @@ import (
 
 const (
 	APIPathInvoices = "invoices"
+
+	InvoiceStatusDraft = "draft"
+	InvoiceStatusIssue = "issue"
+
+	InvoiceContentTypeNormal   = "normal"
+	InvoiceContentTypeDiscount = "discount"
+	InvoiceContentTypeText     = "text"
+
+	InvoicePaymentTypeTransfer    = "transfer"
+	InvoicePaymentTypeDirectDebit = "direct_debit"
+
+	TaxEntryMethodInclusive = "inclusive"
+	TaxEntryMethodExclusive = "exclusive"
 )
 
 type GetInvoicesOpts struct {
@@ -32,6 +47,10 @@ type Invoices struct {
 	Invoices []Invoice `json:"invoices"`
 }
 
+type InvoiceResponse struct {
+	Invoice Invoice `json:"invoice"`
+}
+
 type Invoice struct {
 	// 請求書ID
 	ID int32 `json:"id"`
@@ -50,11 +69,11 @@ type Invoice struct {
 	// 期日 (yyyy-mm-dd)
 	DueDate *string `json:"due_date,omitempty"`
 	// 合計金額
-	TotalAmount int32 `json:"total_amount"`
+	TotalAmount int64 `json:"total_amount"`
 	// 合計金額
-	TotalVat *int32 `json:"total_vat,omitempty"`
+	TotalVat *int64 `json:"total_vat,omitempty"`
 	// 小計
-	SubTotal *int32 `json:"sub_total,omitempty"`
+	SubTotal *int64 `json:"sub_total,omitempty"`
 	// 売上計上日
 	BookingDate *string `json:"booking_date,omitempty"`
 	// 概要
@@ -120,7 +139,7 @@ type Invoice struct {
 	// 請求書の消費税計算方法(inclusive: 内税, exclusive: 外税)
 	TaxEntryMethod string `json:"tax_entry_method"`
 	// 取引ID (invoice_statusがsubmitted, unsubmittedの時IDが表示されます)
-	DealID *int32 `json:"deal_id,omitempty"`
+	DealID *uint64 `json:"deal_id,omitempty"`
 	// 請求内容
 	InvoiceContents       *[]InvoiceContent     `json:"invoice_contents,omitempty"`
 	TotalAmountPerVatRate TotalAmountPerVatRate `json:"total_amount_per_vat_rate"`
@@ -140,9 +159,9 @@ type InvoiceContent struct {
 	// 単価
 	UnitPrice float64 `json:"unit_price"`
 	// 内税/外税の判別とamountの税込み、税抜きについて
-	Amount int32 `json:"amount"`
+	Amount int64 `json:"amount"`
 	// 消費税額
-	Vat int32 `json:"vat"`
+	Vat int64 `json:"vat"`
 	// 軽減税率税区分（true: 対象、false: 対象外）
 	ReducedVat bool `json:"reduced_vat"`
 	// 備考
@@ -181,13 +200,113 @@ type InvoiceContent struct {
 
 type TotalAmountPerVatRate struct {
 	// 税率5%の税込み金額合計
-	Vat5 uint64 `json:"vat_5"`
+	Vat5 int64 `json:"vat_5"`
 	// 税率8%の税込み金額合計
-	Vat8 uint64 `json:"vat_8"`
+	Vat8 int64 `json:"vat_8"`
 	// 軽減税率8%の税込み金額合計
-	ReducedVat8 uint64 `json:"reduced_vat_8"`
+	ReducedVat8 int64 `json:"reduced_vat_8"`
 	// 税率10%の税込み金額合計
-	Vat10 uint64 `json:"vat_10"`
+	Vat10 int64 `json:"vat_10"`
+}
+
+type InvoiceParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// 請求日 (yyyy-mm-dd)
+	IssueDate *string `json:"issue_date,omitempty"`
+	// 取引先ID
+	PartnerID *int32 `json:"partner_id,omitempty"`
+	// 取引先コード
+	PartnerCode *string `json:"partner_code,omitempty"`
+	// 請求書番号 (デフォルト: 自動採番されます)
+	InvoiceNumber *string `json:"invoice_number,omitempty"`
+	// タイトル
+	Title *string `json:"title,omitempty"`
+	// 期日 (yyyy-mm-dd)
+	DueDate *string `json:"due_date,omitempty"`
+	// 売上計上日
+	BookingDate *string `json:"booking_date,omitempty"`
+	// 概要
+	Description *string `json:"description,omitempty"`
+	// 請求書ステータス (draft: 下書き, issue: 発行(請求先ワークフローを利用している場合は承認済みの請求書にのみ指定できます))
+	InvoiceStatus *string `json:"invoice_status,omitempty"`
+	// 請求書に表示する取引先名
+	PartnerDisplayName *string `json:"partner_display_name,omitempty"`
+	// 敬称（御中、様、(空白)の3つから選択）
+	PartnerTitle *string `json:"partner_title,omitempty"`
+	// 取引先担当者名
+	PartnerContactInfo *string `json:"partner_contact_info,omitempty"`
+	// 取引先郵便番号
+	PartnerZipcode *string `json:"partner_zipcode,omitempty"`
+	// 取引先都道府県コード（-1: 設定しない、0:北海道 ... 46:沖縄）
+	PartnerPrefectureCode *int32 `json:"partner_prefecture_code,omitempty"`
+	// 取引先市区町村・番地
+	PartnerAddress1 *string `json:"partner_address1,omitempty"`
+	// 取引先建物名・部屋番号など
+	PartnerAddress2 *string `json:"partner_address2,omitempty"`
+	// 事業所名
+	CompanyName *string `json:"company_name,omitempty"`
+	// 郵便番号
+	CompanyZipcode *string `json:"company_zipcode,omitempty"`
+	// 都道府県コード（-1: 設定しない、0:北海道 ... 46:沖縄）
+	CompanyPrefectureCode *int32 `json:"company_prefecture_code,omitempty"`
+	// 市区町村・番地
+	CompanyAddress1 *string `json:"company_address1,omitempty"`
+	// 建物名・部屋番号など
+	CompanyAddress2 *string `json:"company_address2,omitempty"`
+	// 事業所担当者名
+	CompanyContactInfo *string `json:"company_contact_info,omitempty"`
+	// 支払方法 (振込: transfer, 引き落とし: direct_debit)
+	PaymentType *string `json:"payment_type,omitempty"`
+	// 支払口座
+	PaymentBankInfo *string `json:"payment_bank_info,omitempty"`
+	// メッセージ
+	Message *string `json:"message,omitempty"`
+	// 備考
+	Notes *string `json:"notes,omitempty"`
+	// 請求書レイアウト
+	InvoiceLayout *string `json:"invoice_layout,omitempty"`
+	// 請求書の消費税計算方法(inclusive: 内税, exclusive: 外税)
+	TaxEntryMethod *string `json:"tax_entry_method,omitempty"`
+	// 請求内容
+	InvoiceContents *[]InvoiceContentParams `json:"invoice_contents,omitempty"`
+}
+
+type InvoiceContentParams struct {
+	// 請求内容ID: 既存の請求内容を更新する場合に指定します。IDを指定しない請求内容は、新規行として扱われ追加されます。
+	ID *int32 `json:"id,omitempty"`
+	// 順序
+	Order int32 `json:"order"`
+	// 行の種類 (normal: 通常, discount: 割引, text: テキスト)
+	Type string `json:"type"`
+	// 数量
+	Qty *float64 `json:"qty,omitempty"`
+	// 単位
+	Unit *string `json:"unit,omitempty"`
+	// 単価 (行の種類:normal, discountの時のみ必須)
+	UnitPrice *float64 `json:"unit_price,omitempty"`
+	// 消費税額
+	Vat *int64 `json:"vat,omitempty"`
+	// 軽減税率税区分（true: 対象、false: 対象外）
+	ReducedVat *bool `json:"reduced_vat,omitempty"`
+	// 備考
+	Description *string `json:"description,omitempty"`
+	// 勘定科目ID
+	AccountItemID *int32 `json:"account_item_id,omitempty"`
+	// 税区分コード
+	TaxCode *int32 `json:"tax_code,omitempty"`
+	// 品目ID
+	ItemID *int32 `json:"item_id,omitempty"`
+	// 部門ID
+	SectionID *int32 `json:"section_id,omitempty"`
+	// メモタグID
+	TagIDs *[]int32 `json:"tag_ids,omitempty"`
+	// セグメント１ID
+	Segment1TagID *int32 `json:"segment_1_tag_id,omitempty"`
+	// セグメント２ID
+	Segment2TagID *int32 `json:"segment_2_tag_id,omitempty"`
+	// セグメント３ID
+	Segment3TagID *int32 `json:"segment_3_tag_id,omitempty"`
 }
 
 func (c *Client) GetInvoices(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*Invoices, error) {
@@ -206,6 +325,73 @@ func (c *Client) GetInvoices(ctx context.Context, reuseTokenSource oauth2.TokenS
 	return &result, nil
 }
 
+func (c *Client) GetAllInvoices(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetInvoicesOpts) ([]Invoice, error) {
+	var invoices []Invoice
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitInvoices, &invoices, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetInvoices(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Invoices, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
+func (c *Client) GetInvoice(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, invoiceID int32) (*Invoice, error) {
+	var result InvoiceResponse
+
+	v, err := query.Values(nil)
+	if err != nil {
+		return nil, err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathInvoices, fmt.Sprint(invoiceID)), http.MethodGet, reuseTokenSource, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Invoice, nil
+}
+
+func (c *Client) CreateInvoice(ctx context.Context, reuseTokenSource oauth2.TokenSource, params InvoiceParams) (*Invoice, error) {
+	var result InvoiceResponse
+	err := c.call(ctx, APIPathInvoices, http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Invoice, nil
+}
+
+// UpdateInvoice updates the invoice. Invoice contents which are not included in
+// params.InvoiceContents are deleted, so specify the IDs of the contents to keep.
+func (c *Client) UpdateInvoice(ctx context.Context, reuseTokenSource oauth2.TokenSource, invoiceID int32, params InvoiceParams) (*Invoice, error) {
+	var result InvoiceResponse
+	err := c.call(ctx, path.Join(APIPathInvoices, fmt.Sprint(invoiceID)), http.MethodPut, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Invoice, nil
+}
+
+func (c *Client) DestroyInvoice(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, invoiceID int32) error {
+	v, err := query.Values(nil)
+	if err != nil {
+		return err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathInvoices, fmt.Sprint(invoiceID)), http.MethodDelete, reuseTokenSource, v, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (s *Client) GetInvoiceOrderList() []string {
 	str := new(Invoice)
 
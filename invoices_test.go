@@ -0,0 +1,65 @@
+package freee
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestInvoiceEndpoints(t *testing.T) {
+	t.Parallel()
+	invoice := `{"invoice":{"id":10,"company_id":1}}`
+	id, partnerID := int32(20), int32(3)
+	params := InvoiceParams{
+		CompanyID:     1,
+		IssueDate:     stringPtr("2021-06-01"),
+		PartnerID:     &partnerID,
+		InvoiceStatus: stringPtr(InvoiceStatusDraft),
+		InvoiceContents: &[]InvoiceContentParams{
+			{ID: &id, Order: 0, Type: InvoiceContentTypeNormal, Description: stringPtr("作業費")},
+			{Order: 1, Type: InvoiceContentTypeText, Description: stringPtr("備考")},
+		},
+	}
+	body := `{"company_id":1,"issue_date":"2021-06-01","partner_id":3,"invoice_status":"draft","invoice_contents":[
+		{"id":20,"order":0,"type":"normal","description":"作業費"},
+		{"order":1,"type":"text","description":"備考"}]}`
+
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "get",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.GetInvoice(ctx, ts, 1, 10)
+				return err
+			},
+			method: http.MethodGet, path: "/api/1/invoices/10", query: "company_id=1",
+			response: invoice,
+		},
+		{
+			name: "create",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.CreateInvoice(ctx, ts, params)
+				return err
+			},
+			method: http.MethodPost, path: "/api/1/invoices",
+			body: body, response: invoice,
+		},
+		{
+			name: "update",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.UpdateInvoice(ctx, ts, 10, params)
+				return err
+			},
+			method: http.MethodPut, path: "/api/1/invoices/10",
+			body: body, response: invoice,
+		},
+		{
+			name: "destroy",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				return c.DestroyInvoice(ctx, ts, 1, 10)
+			},
+			method: http.MethodDelete, path: "/api/1/invoices/10", query: "company_id=1",
+		},
+	})
+}
@@ -74,6 +74,24 @@ func (c *Client) GetItems(ctx context.Context, reuseTokenSource oauth2.TokenSour
 	return &result, nil
 }
 
+func (c *Client) GetAllItems(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetItemsOpts) ([]Item, error) {
+	var items []Item
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitItems, &items, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetItems(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Items, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
 func (c *Client) CreateItem(ctx context.Context, reuseTokenSource oauth2.TokenSource, params ItemParams) (*Item, error) {
 	var result ItemResponse
 	err := c.call(ctx, APIPathItems, http.MethodPost, reuseTokenSource, nil, params, &result)
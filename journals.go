@@ -2,9 +2,12 @@ package freee
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"net/http"
 	"path"
 	"reflect"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"golang.org/x/oauth2"
@@ -12,6 +15,26 @@ import (
 
 const (
 	APIPathJournals = "journals"
+
+	// ダウンロード形式
+	JournalDownloadTypeGeneric   = "generic"
+	JournalDownloadTypeGenericV2 = "generic_v2"
+	JournalDownloadTypeCSV       = "csv"
+	JournalDownloadTypePDF       = "pdf"
+	JournalDownloadTypeYayoi     = "yayoi"
+	JournalDownloadTypePCA       = "pca"
+
+	// 集計ステータス
+	JournalStatusEnqueued = "enqueued"
+	JournalStatusWorking  = "working"
+	JournalStatusUploaded = "uploaded"
+	JournalStatusFailed   = "failed"
+)
+
+const (
+	// 仕訳帳の集計ステータスの確認間隔の既定値
+	DefaultJournalsPollInterval    = 2 * time.Second
+	DefaultJournalsPollMaxInterval = 30 * time.Second
 )
 
 type GetJournalsOpts struct {
@@ -23,7 +46,11 @@ type GetJournalsOpts struct {
 }
 
 type Journals struct {
-	Journals []Journal `json:"journals"`
+	Journals Journal `json:"journals"`
+}
+
+type JournalStatusResponse struct {
+	Journals JournalStatus `json:"journals"`
 }
 
 type Journal struct {
@@ -51,6 +78,27 @@ type Journal struct {
 	UpToDateReasons *[]UpToDateReason `json:"up_to_date_reasons,omitempty"`
 }
 
+type JournalStatus struct {
+	// 受け付けID
+	ID int32 `json:"id"`
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// ダウンロード形式
+	DownloadType *string `json:"download_type,omitempty"`
+	// 取得開始日 (yyyy-mm-dd)
+	StartDate *string `json:"start_date,omitempty"`
+	// 取得終了日 (yyyy-mm-dd)
+	EndDate *string `json:"end_date,omitempty"`
+	// 補助科目やコメントとして出力する項目
+	VisibleTags *[]string `json:"visible_tags,omitempty"`
+	// 追加出力するID項目
+	VisibleIDs *[]string `json:"visible_ids,omitempty"`
+	// 集計ステータス (enqueued: 受付済み, working: 集計中, uploaded: 準備完了, failed: 失敗)
+	Status string `json:"status"`
+	// 受け付けメッセージ
+	Messages *[]string `json:"messages,omitempty"`
+}
+
 type UpToDateReason struct {
 	// コード
 	Code string `json:"code"`
@@ -75,6 +123,82 @@ func (c *Client) GetJournals(ctx context.Context, reuseTokenSource oauth2.TokenS
 	return &result, nil
 }
 
+func (c *Client) GetJournalsStatus(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, journalID int32, opts interface{}) (*JournalStatus, error) {
+	var result JournalStatusResponse
+
+	v, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathJournals, "reports", fmt.Sprint(journalID), "status"), http.MethodGet, reuseTokenSource, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.Journals, nil
+}
+
+// DownloadJournals writes the generated journals file to w.
+// The file is available after GetJournalsStatus returns the uploaded status.
+func (c *Client) DownloadJournals(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, journalID int32, opts interface{}, w io.Writer) error {
+	v, err := query.Values(opts)
+	if err != nil {
+		return err
+	}
+
+	SetCompanyID(&v, companyID)
+	return c.call(ctx, path.Join(APIPathJournals, "reports", fmt.Sprint(journalID), "download"), http.MethodGet, reuseTokenSource, v, nil, w)
+}
+
+// WaitJournals polls the status of the journals export with backoff until
+// the file is ready. The interval starts at Config.JournalsPollInterval and
+// doubles up to Config.JournalsPollMaxInterval.
+func (c *Client) WaitJournals(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, journalID int32, opts interface{}) (*JournalStatus, error) {
+	interval, maxInterval := c.config.JournalsPollInterval, c.config.JournalsPollMaxInterval
+	if interval <= 0 {
+		interval = DefaultJournalsPollInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = DefaultJournalsPollMaxInterval
+	}
+	for {
+		status, err := c.GetJournalsStatus(ctx, reuseTokenSource, companyID, journalID, opts)
+		if err != nil {
+			return nil, err
+		}
+		switch status.Status {
+		case JournalStatusUploaded:
+			return status, nil
+		case JournalStatusFailed:
+			return nil, fmt.Errorf("failed to export journals: id=%d", journalID)
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return nil, err
+		}
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// ExportJournals requests a journals export, waits until the file is ready
+// and writes it to w.
+func (c *Client) ExportJournals(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetJournalsOpts, w io.Writer) error {
+	journals, err := c.GetJournals(ctx, reuseTokenSource, companyID, opts)
+	if err != nil {
+		return err
+	}
+	journalID := journals.Journals.ID
+	if _, err := c.WaitJournals(ctx, reuseTokenSource, companyID, journalID, nil); err != nil {
+		return err
+	}
+	return c.DownloadJournals(ctx, reuseTokenSource, companyID, journalID, nil, w)
+}
+
 func (s *Client) GetJournalOrderList() []string {
 	str := new(Journal)
 
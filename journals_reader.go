@@ -0,0 +1,320 @@
+package freee
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+const (
+	journalSideDebit  = "借方"
+	journalSideCredit = "貸方"
+
+	// メモタグが複数ある場合の区切り文字
+	journalTagSeparator = "|"
+
+	// 弥生形式の列数
+	yayoiColumns = 25
+)
+
+// JournalEntry is a row of the downloaded journals file.
+type JournalEntry struct {
+	// 取引日 (yyyy-mm-dd)
+	Date string
+	// 伝票番号
+	TxnNumber string
+	// 決算整理仕訳
+	Adjustment bool
+	// 借方
+	Debit JournalEntryLine
+	// 貸方
+	Credit JournalEntryLine
+	// 摘要
+	Description string
+	// visible_ids で追加出力されたID項目（列名: 値）
+	IDs map[string]string
+}
+
+type JournalEntryLine struct {
+	// 勘定科目名
+	AccountItemName string
+	// 補助科目（弥生形式、PCA形式）
+	SubAccountName string
+	// 税区分
+	TaxCode string
+	// 金額
+	Amount int64
+	// 消費税額
+	Vat int64
+	// 取引先名
+	PartnerName string
+	// 品目
+	ItemName string
+	// 部門
+	SectionName string
+	// メモタグ
+	TagNames []string
+}
+
+// JournalReader reads JournalEntry records from a journals file one by one,
+// so that large files don't have to be held in memory.
+type JournalReader struct {
+	r            *csv.Reader
+	downloadType string
+	visibleTags  []string
+	columns      map[string]int
+	idColumns    []string
+}
+
+// NewJournalReader returns a JournalReader for a file downloaded with
+// downloadType. visibleTags must be the visible_tags of the export request,
+// which decides the contents of 補助科目 in the yayoi format.
+// Both UTF-8 and Shift_JIS encoded files are supported.
+func NewJournalReader(r io.Reader, downloadType string, visibleTags []string) (*JournalReader, error) {
+	switch downloadType {
+	case JournalDownloadTypeGeneric, JournalDownloadTypeGenericV2, JournalDownloadTypeCSV,
+		JournalDownloadTypeYayoi, JournalDownloadTypePCA:
+	default:
+		return nil, fmt.Errorf("unsupported download_type: %s", downloadType)
+	}
+
+	decoded, err := decodeJournalFile(r)
+	if err != nil {
+		return nil, err
+	}
+	cr := csv.NewReader(decoded)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	jr := &JournalReader{
+		r:            cr,
+		downloadType: downloadType,
+		visibleTags:  visibleTags,
+	}
+	if downloadType != JournalDownloadTypeYayoi {
+		header, err := cr.Read()
+		if err != nil {
+			return nil, err
+		}
+		jr.columns = map[string]int{}
+		for i, name := range header {
+			name = strings.TrimSpace(name)
+			jr.columns[name] = i
+			if strings.HasSuffix(name, "ID") {
+				jr.idColumns = append(jr.idColumns, name)
+			}
+		}
+	}
+	return jr, nil
+}
+
+// Read returns the next entry, or io.EOF when there are no more entries.
+func (jr *JournalReader) Read() (*JournalEntry, error) {
+	for {
+		record, err := jr.r.Read()
+		if err != nil {
+			return nil, err
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+		if jr.downloadType == JournalDownloadTypeYayoi {
+			return jr.parseYayoi(record)
+		}
+		return jr.parse(record)
+	}
+}
+
+func (jr *JournalReader) parse(record []string) (*JournalEntry, error) {
+	get := func(names ...string) string {
+		for _, name := range names {
+			if i, ok := jr.columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+		}
+		return ""
+	}
+	line := func(side string) (JournalEntryLine, error) {
+		l := JournalEntryLine{
+			AccountItemName: get(side+"勘定科目", side+"勘定科目名", side+"科目名"),
+			SubAccountName:  get(side+"補助科目", side+"補助科目名", side+"補助名"),
+			TaxCode:         get(side+"税区分", side+"税区分名"),
+			PartnerName:     get(side+"取引先名", side+"取引先"),
+			ItemName:        get(side+"品目", side+"品目名"),
+			SectionName:     get(side+"部門", side+"部門名"),
+			TagNames:        splitJournalTags(get(side+"メモタグ", side+"メモタグ名")),
+		}
+		var err error
+		if l.Amount, err = parseJournalAmount(get(side + "金額")); err != nil {
+			return l, err
+		}
+		if l.Vat, err = parseJournalAmount(get(side+"税額", side+"消費税額", side+"税金額")); err != nil {
+			return l, err
+		}
+		return l, nil
+	}
+
+	entry := &JournalEntry{
+		Date:        normalizeJournalDate(get("取引日", "発生日", "伝票日付")),
+		TxnNumber:   get("伝票番号", "仕訳番号"),
+		Adjustment:  parseJournalFlag(get("決算整理仕訳", "決算")),
+		Description: get("摘要", "摘要文", "備考"),
+	}
+	var err error
+	if entry.Debit, err = line(journalSideDebit); err != nil {
+		return nil, err
+	}
+	if entry.Credit, err = line(journalSideCredit); err != nil {
+		return nil, err
+	}
+	if len(jr.idColumns) > 0 {
+		entry.IDs = map[string]string{}
+		for _, name := range jr.idColumns {
+			if v := get(name); v != "" {
+				entry.IDs[name] = v
+			}
+		}
+	}
+	return entry, nil
+}
+
+// parseYayoi parses a row of the yayoi import format, which has no header.
+// 1:識別フラグ 2:伝票No 3:決算 4:取引日付 5-10:借方 11-16:貸方 17:摘要 ...
+func (jr *JournalReader) parseYayoi(record []string) (*JournalEntry, error) {
+	if len(record) < yayoiColumns {
+		return nil, fmt.Errorf("invalid yayoi record: %d columns", len(record))
+	}
+	line := func(cols []string) (JournalEntryLine, error) {
+		l := JournalEntryLine{
+			AccountItemName: strings.TrimSpace(cols[0]),
+			SubAccountName:  strings.TrimSpace(cols[1]),
+			SectionName:     strings.TrimSpace(cols[2]),
+			TaxCode:         strings.TrimSpace(cols[3]),
+		}
+		// 補助科目には visible_tags の先頭の項目が出力される
+		if len(jr.visibleTags) > 0 {
+			switch jr.visibleTags[0] {
+			case "partner":
+				l.PartnerName = l.SubAccountName
+			case "item":
+				l.ItemName = l.SubAccountName
+			case "tag":
+				l.TagNames = splitJournalTags(l.SubAccountName)
+			}
+		}
+		var err error
+		if l.Amount, err = parseJournalAmount(cols[4]); err != nil {
+			return l, err
+		}
+		if l.Vat, err = parseJournalAmount(cols[5]); err != nil {
+			return l, err
+		}
+		return l, nil
+	}
+
+	entry := &JournalEntry{
+		Date:        normalizeJournalDate(strings.TrimSpace(record[3])),
+		TxnNumber:   strings.TrimSpace(record[1]),
+		Adjustment:  parseJournalFlag(strings.TrimSpace(record[2])),
+		Description: strings.TrimSpace(record[16]),
+	}
+	var err error
+	if entry.Debit, err = line(record[4:10]); err != nil {
+		return nil, err
+	}
+	if entry.Credit, err = line(record[10:16]); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// decodeJournalFile detects whether r is UTF-8 or Shift_JIS and returns
+// a UTF-8 reader without BOM.
+func decodeJournalFile(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReaderSize(r, 64*1024)
+	head, err := br.Peek(64 * 1024)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, err
+	}
+	if bytes.HasPrefix(head, []byte("\xef\xbb\xbf")) {
+		if _, err := br.Discard(3); err != nil {
+			return nil, err
+		}
+		return br, nil
+	}
+	// 末尾で途切れたマルチバイト文字は判定から除外する
+	if i := lastRuneStart(head); i < len(head) && !utf8.FullRune(head[i:]) {
+		head = head[:i]
+	}
+	if utf8.Valid(head) {
+		return br, nil
+	}
+	return transform.NewReader(br, japanese.ShiftJIS.NewDecoder()), nil
+}
+
+func lastRuneStart(b []byte) int {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			return i
+		}
+	}
+	return len(b)
+}
+
+func isBlankRecord(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func parseJournalAmount(v string) (int64, error) {
+	v = strings.Replace(strings.TrimSpace(v), ",", "", -1)
+	if v == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(v, 10, 64)
+}
+
+func parseJournalFlag(v string) bool {
+	switch v {
+	case "", "0", "false", "FALSE":
+		return false
+	}
+	return true
+}
+
+// normalizeJournalDate converts yyyy/m/d (padded or not) and yyyymmdd to yyyy-mm-dd.
+// A value in another format is returned as it is.
+func normalizeJournalDate(v string) string {
+	for _, layout := range []string{"2006/1/2", "20060102", "2006-1-2"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t.Format(dateLayout)
+		}
+	}
+	return v
+}
+
+func splitJournalTags(v string) []string {
+	if v == "" {
+		return nil
+	}
+	var tags []string
+	for _, tag := range strings.Split(v, journalTagSeparator) {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
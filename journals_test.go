@@ -0,0 +1,175 @@
+package freee
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+)
+
+func TestExportJournals(t *testing.T) {
+	t.Parallel()
+	var polls int32
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/journals" && r.URL.RawQuery != "company_id=1" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		switch r.URL.Path {
+		case "/api/1/journals":
+			if r.URL.Query().Get("download_type") != JournalDownloadTypeGeneric {
+				t.Errorf("unexpected query: %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"journals":{"id":10,"company_id":1,"status_url":"https://api.freee.co.jp/api/1/journals/reports/10/status"}}`)
+		case "/api/1/journals/reports/10/status":
+			status := JournalStatusWorking
+			if atomic.AddInt32(&polls, 1) >= 3 {
+				status = JournalStatusUploaded
+			}
+			fmt.Fprintf(w, `{"journals":{"id":10,"company_id":1,"status":"%s"}}`, status)
+		case "/api/1/journals/reports/10/download":
+			fmt.Fprint(w, "journal,csv\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	client.config.JournalsPollInterval = time.Millisecond
+	client.config.JournalsPollMaxInterval = time.Millisecond
+
+	var buf bytes.Buffer
+	opts := GetJournalsOpts{DownloadType: JournalDownloadTypeGeneric}
+	if err := client.ExportJournals(context.Background(), ts, 1, opts, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "journal,csv\n" {
+		t.Fatalf("unexpected file: %q", buf.String())
+	}
+	if polls != 3 {
+		t.Fatalf("unmatch poll nums : %d", polls)
+	}
+}
+
+func TestJournalReader(t *testing.T) {
+	t.Parallel()
+	shiftJIS := func(s string) string {
+		b, _, err := transform.String(japanese.ShiftJIS.NewEncoder(), s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	tests := []struct {
+		name         string
+		downloadType string
+		visibleTags  []string
+		data         string
+		want         []JournalEntry
+	}{
+		{
+			name:         "generic",
+			downloadType: JournalDownloadTypeGeneric,
+			data: "\xef\xbb\xbf取引日,伝票番号,決算整理仕訳,借方勘定科目,借方取引先名,借方メモタグ,借方金額,借方税区分,借方税額,貸方勘定科目,貸方金額,貸方税区分,貸方税額,摘要,取引ID\n" +
+				"2021/06/01,1,,旅費交通費,取引先A,タグA|タグB,\"3,300\",課対仕入10%,300,現金,\"3,300\",対象外,0,交通費,100\n",
+			want: []JournalEntry{{
+				Date:      "2021-06-01",
+				TxnNumber: "1",
+				Debit: JournalEntryLine{
+					AccountItemName: "旅費交通費", PartnerName: "取引先A", TagNames: []string{"タグA", "タグB"},
+					Amount: 3300, TaxCode: "課対仕入10%", Vat: 300,
+				},
+				Credit:      JournalEntryLine{AccountItemName: "現金", Amount: 3300, TaxCode: "対象外"},
+				Description: "交通費",
+				IDs:         map[string]string{"取引ID": "100"},
+			}},
+		},
+		{
+			name:         "yayoi",
+			downloadType: JournalDownloadTypeYayoi,
+			visibleTags:  []string{"partner"},
+			data: shiftJIS("2000,1,本決,2021/6/30,売掛金,取引先B,,対象外,11000,0,売上高,取引先B,営業部,課税売上10%,11000,1000,売上,,,0,,,0,,no\n" +
+				"\n"),
+			want: []JournalEntry{{
+				Date:       "2021-06-30",
+				TxnNumber:  "1",
+				Adjustment: true,
+				Debit: JournalEntryLine{
+					AccountItemName: "売掛金", SubAccountName: "取引先B", PartnerName: "取引先B",
+					TaxCode: "対象外", Amount: 11000,
+				},
+				Credit: JournalEntryLine{
+					AccountItemName: "売上高", SubAccountName: "取引先B", PartnerName: "取引先B", SectionName: "営業部",
+					TaxCode: "課税売上10%", Amount: 11000, Vat: 1000,
+				},
+				Description: "売上",
+			}},
+		},
+		{
+			name:         "pca",
+			downloadType: JournalDownloadTypePCA,
+			data: shiftJIS("伝票日付,伝票番号,借方科目名,借方補助名,借方部門名,借方税区分名,借方金額,借方消費税額,貸方科目名,貸方補助名,貸方部門名,貸方税区分名,貸方金額,貸方消費税額,摘要文\n" +
+				"20210701,5,普通預金,銀行A,,対象外,500,0,受取利息,,,非課売上,500,0,利息\n"),
+			want: []JournalEntry{{
+				Date:        "2021-07-01",
+				TxnNumber:   "5",
+				Debit:       JournalEntryLine{AccountItemName: "普通預金", SubAccountName: "銀行A", TaxCode: "対象外", Amount: 500},
+				Credit:      JournalEntryLine{AccountItemName: "受取利息", TaxCode: "非課売上", Amount: 500},
+				Description: "利息",
+			}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r, err := NewJournalReader(strings.NewReader(tt.data), tt.downloadType, tt.visibleTags)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []JournalEntry
+			for {
+				entry, err := r.Read()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, *entry)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("unexpected entries:\n%#v\n%#v", tt.want, got)
+			}
+		})
+	}
+
+	if _, err := NewJournalReader(strings.NewReader(""), JournalDownloadTypePDF, nil); err == nil {
+		t.Fatal("pdf is not supported")
+	}
+}
+
+func TestNormalizeJournalDate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"2021/06/01", "2021-06-01"},
+		{"2021/6/1", "2021-06-01"},
+		{"20210701", "2021-07-01"},
+		{"2021-7-1", "2021-07-01"},
+		{"invalid", "invalid"},
+	}
+	for _, tt := range tests {
+		if got := normalizeJournalDate(tt.in); got != tt.want {
+			t.Fatalf("unexpected date: %s, %s", tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package freee
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	LimiterKeyPrefixCompanyID = "company_id:"
+	LimiterKeyPrefixToken     = "token:"
+)
+
+// Limiter is a generic interface for client-side rate limiting.
+// Wait blocks until a request counted against key may be sent.
+// Each attempt of a request, including the retries, waits for all the keys
+// of the request in order. If Wait fails for a later key, the tokens already
+// taken for the earlier keys are not returned; TokenBucketLimiter reserves
+// all the keys together instead.
+type Limiter interface {
+	Wait(ctx context.Context, key string) error
+}
+
+// multiKeyLimiter is a Limiter which reserves the keys of a request together.
+type multiKeyLimiter interface {
+	waitKeys(ctx context.Context, keys []string) error
+}
+
+// TokenBucketLimiter is a Limiter which keeps one token bucket per key,
+// so that goroutines sharing a Client share the same budget.
+// The rate and the burst are fixed by NewTokenBucketLimiter.
+type TokenBucketLimiter struct {
+	// 1秒あたりに補充されるトークン数
+	rate float64
+	// バケットの容量
+	burst int
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucketLimiter returns a TokenBucketLimiter which allows rate
+// requests per second with bursts of up to burst requests for each key.
+func NewTokenBucketLimiter(rate float64, burst int) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		rate:    rate,
+		burst:   burst,
+		buckets: map[string]*tokenBucket{},
+	}
+}
+
+func (l *TokenBucketLimiter) Wait(ctx context.Context, key string) error {
+	wait := l.reserve(key)
+	if wait <= 0 {
+		return nil
+	}
+	if err := sleepContext(ctx, wait); err != nil {
+		l.cancel(key)
+		return err
+	}
+	return nil
+}
+
+// waitKeys reserves a token of every key at once, and waits for the longest of
+// them. The tokens are returned if ctx is done before that.
+func (l *TokenBucketLimiter) waitKeys(ctx context.Context, keys []string) error {
+	var wait time.Duration
+	for _, key := range keys {
+		if d := l.reserve(key); d > wait {
+			wait = d
+		}
+	}
+	if wait <= 0 {
+		return nil
+	}
+	if err := sleepContext(ctx, wait); err != nil {
+		for _, key := range keys {
+			l.cancel(key)
+		}
+		return err
+	}
+	return nil
+}
+
+func (l *TokenBucketLimiter) reserve(key string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.buckets == nil {
+		l.buckets = map[string]*tokenBucket{}
+	}
+	now := time.Now()
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: float64(l.burst), last: now}
+		l.buckets[key] = b
+	}
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > float64(l.burst) {
+		b.tokens = float64(l.burst)
+	}
+	b.last = now
+
+	// トークンが不足している場合は負の値として予約し、補充されるまで待機する
+	b.tokens--
+	if b.tokens >= 0 || l.rate <= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / l.rate * float64(time.Second))
+}
+
+func (l *TokenBucketLimiter) cancel(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if b, ok := l.buckets[key]; ok {
+		b.tokens++
+	}
+}
+
+// limiterKeys returns the keys a request is counted against.
+func limiterKeys(reuseTokenSource oauth2.TokenSource, companyID string) ([]string, error) {
+	var keys []string
+	if companyID != "" {
+		keys = append(keys, LimiterKeyPrefixCompanyID+companyID)
+	}
+	if reuseTokenSource != nil {
+		token, err := reuseTokenSource.Token()
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256([]byte(token.AccessToken))
+		keys = append(keys, LimiterKeyPrefixToken+hex.EncodeToString(sum[:8]))
+	}
+	return keys, nil
+}
+
+// companyIDFromJSON extracts company_id from a JSON request body.
+func companyIDFromJSON(body []byte) string {
+	var v struct {
+		CompanyID json.Number `json:"company_id"`
+	}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return ""
+	}
+	return v.CompanyID.String()
+}
+
+// limiterKeys returns the keys the requests with queryParams and companyID of
+// the body are counted against, or nil if no Limiter is configured.
+func (c *Client) limiterKeys(reuseTokenSource oauth2.TokenSource, queryParams url.Values, companyID string) ([]string, error) {
+	if c.config.Limiter == nil {
+		return nil, nil
+	}
+	if id := queryParams.Get("company_id"); id != "" {
+		companyID = id
+	}
+	keys, err := limiterKeys(reuseTokenSource, companyID)
+	if err != nil {
+		return nil, c.handleRequestError(err)
+	}
+	return keys, nil
+}
+
+// wait blocks until an attempt counted against keys may be sent.
+func (c *Client) wait(ctx context.Context, keys []string) error {
+	if c.config.Limiter == nil || len(keys) == 0 {
+		return nil
+	}
+	if l, ok := c.config.Limiter.(multiKeyLimiter); ok {
+		return l.waitKeys(ctx, keys)
+	}
+	for _, key := range keys {
+		if err := c.config.Limiter.Wait(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package freee
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketLimiter(t *testing.T) {
+	t.Parallel()
+	l := NewTokenBucketLimiter(50, 1)
+	ctx := context.Background()
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(ctx, "a"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Fatalf("limiter did not wait: %v", elapsed)
+	}
+
+	// 別のキーは別の予算を持つ
+	start = time.Now()
+	if err := l.Wait(ctx, "b"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Millisecond {
+		t.Fatalf("limiter waited for another key: %v", elapsed)
+	}
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := l.Wait(canceled, "a"); err != context.Canceled {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+type recordLimiter struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (l *recordLimiter) Wait(ctx context.Context, key string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.keys = append(l.keys, key)
+	return nil
+}
+
+func TestClientLimiterKeys(t *testing.T) {
+	t.Parallel()
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	})
+	limiter := &recordLimiter{}
+	client.config.Limiter = limiter
+
+	ctx := context.Background()
+	if _, err := client.GetItems(ctx, ts, 123, GetItemsOpts{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CreateItem(ctx, ts, ItemParams{CompanyID: 456, Name: "x"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetUsersMe(ctx, ts, GetUsersMeOpts{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(limiter.keys) != 5 {
+		t.Fatalf("unmatch key nums : %d", len(limiter.keys))
+	}
+	if limiter.keys[0] != "company_id:123" || limiter.keys[2] != "company_id:456" {
+		t.Fatalf("unexpected keys: %v", limiter.keys)
+	}
+	if limiter.keys[1] != limiter.keys[4] {
+		t.Fatalf("token keys differ: %v", limiter.keys)
+	}
+}
+
+func TestTokenBucketLimiterWaitKeys(t *testing.T) {
+	t.Parallel()
+	l := NewTokenBucketLimiter(1, 1)
+	ctx := context.Background()
+	if err := l.Wait(ctx, "b"); err != nil {
+		t.Fatal(err)
+	}
+
+	// b の待機中にキャンセルされた場合、a のトークンも戻す
+	canceled, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := l.waitKeys(canceled, []string{"a", "b"}); err != context.DeadlineExceeded {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	start := time.Now()
+	if err := l.Wait(ctx, "a"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Millisecond {
+		t.Fatalf("token of a is not returned: %v", elapsed)
+	}
+}
+
+func TestClientLimiterRetries(t *testing.T) {
+	t.Parallel()
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	})
+	limiter := &recordLimiter{}
+	client.config.Limiter = limiter
+	client.config.Retry = &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+
+	if _, err := client.GetItems(context.Background(), ts, 123, GetItemsOpts{}); err != nil {
+		t.Fatal(err)
+	}
+	// リトライも含めて試行ごとに会社とトークンのキーで待機する
+	if calls != 3 || len(limiter.keys) != 6 {
+		t.Fatalf("unmatch key nums : %d, %d", 6, len(limiter.keys))
+	}
+	for i := 0; i < len(limiter.keys); i += 2 {
+		if limiter.keys[i] != "company_id:123" || limiter.keys[i+1] != limiter.keys[1] {
+			t.Fatalf("unexpected keys: %v", limiter.keys)
+		}
+	}
+}
@@ -16,6 +16,9 @@ const (
 
 	ManualJournalEntrySideCredit = "credit"
 	ManualJournalEntrySideDebit  = "debit"
+
+	// 振替伝票の貸借行の上限
+	ManualJournalMaxDetails = 100
 )
 
 type ManualJournalsResponse struct {
@@ -43,7 +46,7 @@ type ManualJournal struct {
 
 type ManualJournalDetails struct {
 	// 貸借行ID
-	ID int64 `json:"id"`
+	ID uint64 `json:"id"`
 	// 貸借(貸方: credit, 借方: debit)
 	EntrySide string `json:"entry_side"`
 	// 勘定科目ID
@@ -81,9 +84,9 @@ type ManualJournalDetails struct {
 	// セグメント３
 	Segment3TagName *string `json:"segment_3_tag_name,omitempty"`
 	// 金額（税込で指定してください）
-	Amount int32 `json:"amount"`
+	Amount int64 `json:"amount"`
 	// 消費税額（指定しない場合は自動で計算されます）
-	Vat int32 `json:"vat"`
+	Vat int64 `json:"vat"`
 	// 備考
 	Description string `json:"description"`
 }
@@ -110,7 +113,7 @@ type CreateManualJournalParamsDetail struct {
 	// 取引金額（税込で指定してください）
 	Amount int64 `json:"amount"`
 	// 消費税額（指定しない場合は自動で計算されます）
-	Vat *int32 `json:"vat,omitempty"`
+	Vat *int64 `json:"vat,omitempty"`
 	// 取引先ID
 	PartnerID int32 `json:"partner_id,omitempty"`
 	// 取引先コード
@@ -152,9 +155,9 @@ type UpdateManualJournalParamsDetails struct {
 	// 勘定科目ID
 	AccountItemID int32 `json:"account_item_id"`
 	// 取引金額（税込で指定してください）
-	Amount int32 `json:"amount"`
+	Amount int64 `json:"amount"`
 	// 消費税額（指定しない場合は自動で計算されます）
-	Vat *int32 `json:"vat,omitempty"`
+	Vat *int64 `json:"vat,omitempty"`
 	// 取引先ID
 	PartnerID int32 `json:"partner_id,omitempty"`
 	// 取引先コード
@@ -175,6 +178,54 @@ type UpdateManualJournalParamsDetails struct {
 	Description string `json:"description,omitempty"`
 }
 
+// ManualJournalChanges is a set of changes to the details of an existing manual journal.
+type ManualJournalChanges struct {
+	// 発生日 (yyyy-mm-dd): 未指定の場合は変更しません
+	IssueDate *string
+	// 決算整理仕訳フラグ: 未指定の場合は変更しません
+	Adjustment *bool
+	// 更新する貸借行（貸借行IDをキーに指定します）
+	Update map[uint64]ManualJournalDetailChange
+	// 追加する貸借行
+	Add []UpdateManualJournalParamsDetails
+	// 削除する貸借行ID
+	Delete []uint64
+}
+
+// ManualJournalDetailChange is a change to an existing detail of a manual journal.
+// Nil fields are not changed, and the zero values clear the optional fields.
+// If Amount or TaxCode is changed without Vat, the vat is calculated by freee.
+type ManualJournalDetailChange struct {
+	// 貸借（貸方: credit, 借方: debit）
+	EntrySide *string
+	// 税区分コード
+	TaxCode *int32
+	// 勘定科目ID
+	AccountItemID *int32
+	// 取引金額（税込で指定してください）
+	Amount *int64
+	// 消費税額
+	Vat *int64
+	// 取引先ID（0 の場合は取引先を外します）
+	PartnerID *int32
+	// 取引先コード
+	PartnerCode *string
+	// 品目ID（0 の場合は品目を外します）
+	ItemID *int32
+	// 部門ID（0 の場合は部門を外します）
+	SectionID *int32
+	// メモタグID（空の場合はメモタグを外します）
+	TagIDs *[]int32
+	// セグメント１ID（0 の場合はセグメントを外します）
+	Segment1TagID *int32
+	// セグメント２ID（0 の場合はセグメントを外します）
+	Segment2TagID *int32
+	// セグメント３ID（0 の場合はセグメントを外します）
+	Segment3TagID *int32
+	// 備考（空の場合は備考を消します）
+	Description *string
+}
+
 type GetManualJournalsOpts struct {
 	// 発生日で絞込：開始日(yyyy-mm-dd)
 	StartIssueDate string `url:"start_issue_date,omitempty"`
@@ -183,8 +234,8 @@ type GetManualJournalsOpts struct {
 	// 貸借で絞込 (貸方: credit, 借方: debit)
 	EntrySide        string `url:"entry_side,omitempty"`
 	AccountItemID    int32  `url:"account_item_id,omitempty"`
-	MinAmount        int32  `url:"min_amount,omitempty"`
-	MaxAmount        int32  `url:"max_amount,omitempty"`
+	MinAmount        int64  `url:"min_amount,omitempty"`
+	MaxAmount        int64  `url:"max_amount,omitempty"`
 	PartnerID        int32  `url:"partner_id,omitempty"`
 	PartnerCode      string `url:"partner_code,omitempty"`
 	ItemID           int32  `url:"item_id,omitempty"`
@@ -211,6 +262,22 @@ func (c *Client) CreateManualJournal(ctx context.Context, reuseTokenSource oauth
 	return &result, nil
 }
 
+func (c *Client) GetManualJournal(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, journalID int32) (*ManualJournalResponse, error) {
+	var result ManualJournalResponse
+
+	v, err := query.Values(nil)
+	if err != nil {
+		return nil, err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathManualJournals, fmt.Sprint(journalID)), http.MethodGet, reuseTokenSource, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 func (c *Client) UpdateManualJournal(ctx context.Context, reuseTokenSource oauth2.TokenSource, journalID int32, params UpdateManualJournalParams) (*ManualJournalResponse, error) {
 	var result ManualJournalResponse
 
@@ -253,6 +320,188 @@ func (c *Client) GetManualJournals(ctx context.Context, reuseTokenSource oauth2.
 	return &result, nil
 }
 
+func (c *Client) GetAllManualJournals(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetManualJournalsOpts) ([]ManualJournal, error) {
+	var journals []ManualJournal
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitManualJournals, &journals, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetManualJournals(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.ManualJournals, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return journals, nil
+}
+
+// UpdateManualJournalDetails applies changes to the journal and updates it.
+// Details which are not changed are sent with their IDs, so they are kept.
+// See (*ManualJournal).UpdateParams for the validation before the request.
+func (c *Client) UpdateManualJournalDetails(ctx context.Context, reuseTokenSource oauth2.TokenSource, journal *ManualJournal, changes ManualJournalChanges) (*ManualJournalResponse, error) {
+	params, err := journal.UpdateParams(changes)
+	if err != nil {
+		return nil, err
+	}
+	return c.UpdateManualJournal(ctx, reuseTokenSource, journal.ID, *params)
+}
+
+// UpdateParams builds the params to update the journal with changes.
+// It returns an error without any request if a changed detail is not found,
+// the number of details exceeds ManualJournalMaxDetails, or the debit total
+// does not match the credit total.
+func (j *ManualJournal) UpdateParams(changes ManualJournalChanges) (*UpdateManualJournalParams, error) {
+	params := &UpdateManualJournalParams{
+		CompanyID:  j.CompanyID,
+		IssueDate:  j.IssueDate,
+		Adjustment: j.Adjustment,
+	}
+	if changes.IssueDate != nil {
+		params.IssueDate = *changes.IssueDate
+	}
+	if changes.Adjustment != nil {
+		params.Adjustment = *changes.Adjustment
+	}
+
+	deleted := map[uint64]bool{}
+	for _, id := range changes.Delete {
+		deleted[id] = true
+	}
+	found := map[uint64]bool{}
+	for _, d := range j.Details {
+		if deleted[d.ID] {
+			found[d.ID] = true
+			continue
+		}
+		detail := d.updateParams()
+		if change, ok := changes.Update[d.ID]; ok {
+			found[d.ID] = true
+			detail.overlay(change)
+		}
+		params.Details = append(params.Details, detail)
+	}
+	for id := range deleted {
+		if !found[id] {
+			return nil, fmt.Errorf("manual journal detail %d to delete is not found", id)
+		}
+	}
+	for id := range changes.Update {
+		if !found[id] {
+			return nil, fmt.Errorf("manual journal detail %d to update is not found", id)
+		}
+		if deleted[id] {
+			return nil, fmt.Errorf("manual journal detail %d is both updated and deleted", id)
+		}
+	}
+	for _, detail := range changes.Add {
+		if detail.ID != 0 {
+			return nil, fmt.Errorf("manual journal detail to add must not have id: %d", detail.ID)
+		}
+		params.Details = append(params.Details, detail)
+	}
+
+	if len(params.Details) > ManualJournalMaxDetails {
+		return nil, fmt.Errorf("manual journal must have %d details or less: %d", ManualJournalMaxDetails, len(params.Details))
+	}
+	var debit, credit int64
+	for _, detail := range params.Details {
+		switch detail.EntrySide {
+		case ManualJournalEntrySideDebit:
+			debit += detail.Amount
+		case ManualJournalEntrySideCredit:
+			credit += detail.Amount
+		default:
+			return nil, fmt.Errorf("invalid entry side: %q", detail.EntrySide)
+		}
+	}
+	if debit != credit {
+		return nil, fmt.Errorf("debit total %d does not match credit total %d", debit, credit)
+	}
+	return params, nil
+}
+
+func (d *ManualJournalDetails) updateParams() UpdateManualJournalParamsDetails {
+	vat := d.Vat
+	detail := UpdateManualJournalParamsDetails{
+		ID:            d.ID,
+		EntrySide:     d.EntrySide,
+		TaxCode:       d.TaxCode,
+		AccountItemID: d.AccountItemID,
+		Amount:        d.Amount,
+		Vat:           &vat,
+		PartnerID:     d.PartnerID,
+		ItemID:        d.ItemID,
+		SectionID:     d.SectionID,
+		TagIDs:        append([]int32(nil), d.TagIDs...),
+		Description:   d.Description,
+	}
+	if d.Segment1TagID != nil {
+		detail.Segment1TagID = *d.Segment1TagID
+	}
+	if d.Segment2TagID != nil {
+		detail.Segment2TagID = *d.Segment2TagID
+	}
+	if d.Segment3TagID != nil {
+		detail.Segment3TagID = *d.Segment3TagID
+	}
+	return detail
+}
+
+// overlay applies the change to the detail.
+func (d *UpdateManualJournalParamsDetails) overlay(change ManualJournalDetailChange) {
+	if change.EntrySide != nil {
+		d.EntrySide = *change.EntrySide
+	}
+	if change.TaxCode != nil {
+		d.TaxCode = *change.TaxCode
+	}
+	if change.AccountItemID != nil {
+		d.AccountItemID = *change.AccountItemID
+	}
+	if change.Amount != nil {
+		d.Amount = *change.Amount
+	}
+	// 金額か税区分を変更した場合、既存の消費税額は使わずに再計算させる
+	if change.Vat != nil {
+		vat := *change.Vat
+		d.Vat = &vat
+	} else if change.Amount != nil || change.TaxCode != nil {
+		d.Vat = nil
+	}
+	if change.PartnerID != nil {
+		d.PartnerID = *change.PartnerID
+		d.PartnerCode = ""
+	}
+	if change.PartnerCode != nil {
+		d.PartnerID = 0
+		d.PartnerCode = *change.PartnerCode
+	}
+	if change.ItemID != nil {
+		d.ItemID = *change.ItemID
+	}
+	if change.SectionID != nil {
+		d.SectionID = *change.SectionID
+	}
+	if change.TagIDs != nil {
+		d.TagIDs = append([]int32(nil), *change.TagIDs...)
+	}
+	if change.Segment1TagID != nil {
+		d.Segment1TagID = *change.Segment1TagID
+	}
+	if change.Segment2TagID != nil {
+		d.Segment2TagID = *change.Segment2TagID
+	}
+	if change.Segment3TagID != nil {
+		d.Segment3TagID = *change.Segment3TagID
+	}
+	if change.Description != nil {
+		d.Description = *change.Description
+	}
+}
+
 func (s *Client) GetManualJournalOrderList() []string {
 	str := new(ManualJournal)
 
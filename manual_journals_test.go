@@ -0,0 +1,201 @@
+package freee
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestManualJournalUpdateParams(t *testing.T) {
+	t.Parallel()
+	segment := int32(7)
+	journal := &ManualJournal{
+		ID:        1,
+		CompanyID: 1,
+		IssueDate: "2021-06-01",
+		Details: []ManualJournalDetails{
+			{ID: 11, EntrySide: ManualJournalEntrySideDebit, AccountItemID: 100, TaxCode: 2, Amount: 1000, Segment1TagID: &segment},
+			{ID: 12, EntrySide: ManualJournalEntrySideCredit, AccountItemID: 200, TaxCode: 2, Amount: 600},
+			{ID: 13, EntrySide: ManualJournalEntrySideCredit, AccountItemID: 300, TaxCode: 2, Amount: 400},
+		},
+	}
+	amount1000, amount1500 := int64(1000), int64(1500)
+	tests := []struct {
+		name    string
+		changes ManualJournalChanges
+		wantIDs []uint64
+		wantErr string
+	}{
+		{
+			name:    "keep",
+			wantIDs: []uint64{11, 12, 13},
+		},
+		{
+			name: "update and add",
+			changes: ManualJournalChanges{
+				Update: map[uint64]ManualJournalDetailChange{
+					11: {Amount: &amount1500},
+				},
+				Add: []UpdateManualJournalParamsDetails{
+					{EntrySide: ManualJournalEntrySideCredit, AccountItemID: 400, TaxCode: 2, Amount: 500},
+				},
+			},
+			wantIDs: []uint64{11, 12, 13, 0},
+		},
+		{
+			name: "delete",
+			changes: ManualJournalChanges{
+				Update: map[uint64]ManualJournalDetailChange{
+					12: {Amount: &amount1000},
+				},
+				Delete: []uint64{13},
+			},
+			wantIDs: []uint64{11, 12},
+		},
+		{
+			name:    "unbalanced",
+			changes: ManualJournalChanges{Delete: []uint64{13}},
+			wantErr: "does not match",
+		},
+		{
+			name:    "unknown detail",
+			changes: ManualJournalChanges{Delete: []uint64{99}},
+			wantErr: "not found",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			params, err := journal.UpdateParams(tt.changes)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(params.Details) != len(tt.wantIDs) {
+				t.Fatalf("unmatch detail nums : %d", len(params.Details))
+			}
+			for i, id := range tt.wantIDs {
+				if params.Details[i].ID != id {
+					t.Fatalf("unexpected detail %d: %+v", i, params.Details[i])
+				}
+			}
+			if params.IssueDate != "2021-06-01" {
+				t.Fatalf("unexpected params: %+v", params)
+			}
+			// 変更しない貸借行は既存の値を引き継ぐ
+			if _, ok := tt.changes.Update[11]; !ok && (params.Details[0].Segment1TagID != 7 || *params.Details[0].Vat != 0) {
+				t.Fatalf("unexpected detail: %+v", params.Details[0])
+			}
+		})
+	}
+}
+
+func TestManualJournalUpdateParamsPartial(t *testing.T) {
+	t.Parallel()
+	segment := int32(7)
+	journal := &ManualJournal{
+		ID:        1,
+		CompanyID: 1,
+		IssueDate: "2021-06-01",
+		Details: []ManualJournalDetails{
+			{ID: 11, EntrySide: ManualJournalEntrySideDebit, AccountItemID: 100, TaxCode: 2, Amount: 1000, PartnerID: 5, ItemID: 6, TagIDs: []int32{8}, Segment1TagID: &segment, Description: "交通費"},
+			{ID: 12, EntrySide: ManualJournalEntrySideCredit, AccountItemID: 200, TaxCode: 2, Amount: 1000},
+		},
+	}
+
+	description := "旅費"
+	params, err := journal.UpdateParams(ManualJournalChanges{
+		Update: map[uint64]ManualJournalDetailChange{
+			11: {Description: &description},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := params.Details[0]
+	if got.ID != 11 || got.EntrySide != ManualJournalEntrySideDebit || got.AccountItemID != 100 || got.TaxCode != 2 || got.Amount != 1000 ||
+		got.PartnerID != 5 || got.ItemID != 6 || len(got.TagIDs) != 1 || got.Segment1TagID != 7 || got.Description != "旅費" {
+		t.Fatalf("unexpected detail: %+v", got)
+	}
+	// 更新用の貸借行は取得した仕訳とメモタグを共有しない
+	got.TagIDs[0] = 9
+	if journal.Details[0].TagIDs[0] != 8 {
+		t.Fatalf("journal is modified by params: %+v", journal.Details[0])
+	}
+
+	// ゼロ値を指定した項目は外す
+	var none int32
+	empty := ""
+	params, err = journal.UpdateParams(ManualJournalChanges{
+		Update: map[uint64]ManualJournalDetailChange{
+			11: {PartnerID: &none, ItemID: &none, Segment1TagID: &none, TagIDs: &[]int32{}, Description: &empty},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = params.Details[0]
+	if got.PartnerID != 0 || got.ItemID != 0 || len(got.TagIDs) != 0 || got.Segment1TagID != 0 || got.Description != "" || got.AccountItemID != 100 {
+		t.Fatalf("unexpected detail: %+v", got)
+	}
+}
+
+func TestUpdateManualJournalDetailsVat(t *testing.T) {
+	t.Parallel()
+	journal := &ManualJournal{
+		ID:        1,
+		CompanyID: 1,
+		IssueDate: "2021-06-01",
+		Details: []ManualJournalDetails{
+			{ID: 11, EntrySide: ManualJournalEntrySideDebit, AccountItemID: 100, TaxCode: 136, Amount: 1100, Vat: 100},
+			{ID: 12, EntrySide: ManualJournalEntrySideCredit, AccountItemID: 200, TaxCode: 2, Amount: 1100},
+		},
+	}
+	amount := int64(2200)
+	changes := ManualJournalChanges{
+		Update: map[uint64]ManualJournalDetailChange{
+			11: {Amount: &amount},
+			12: {Amount: &amount},
+		},
+	}
+
+	// 金額を変更した貸借行は消費税額を送らず、変更しない項目の消費税額はそのまま送る
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "amount",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				_, err := c.UpdateManualJournalDetails(ctx, ts, journal, changes)
+				return err
+			},
+			method: http.MethodPut, path: "/api/1/manual_journals/1",
+			body: `{"company_id":1,"issue_date":"2021-06-01","details":[
+				{"id":11,"entry_side":"debit","tax_code":136,"account_item_id":100,"amount":2200},
+				{"id":12,"entry_side":"credit","tax_code":2,"account_item_id":200,"amount":2200}]}`,
+			response: `{"manual_journal":{"id":1,"company_id":1}}`,
+		},
+		{
+			name: "description",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				description := "修正"
+				_, err := c.UpdateManualJournalDetails(ctx, ts, journal, ManualJournalChanges{
+					Update: map[uint64]ManualJournalDetailChange{11: {Description: &description}},
+				})
+				return err
+			},
+			method: http.MethodPut, path: "/api/1/manual_journals/1",
+			body: `{"company_id":1,"issue_date":"2021-06-01","details":[
+				{"id":11,"entry_side":"debit","tax_code":136,"account_item_id":100,"amount":1100,"vat":100,"description":"修正"},
+				{"id":12,"entry_side":"credit","tax_code":2,"account_item_id":200,"amount":1100,"vat":0}]}`,
+			response: `{"manual_journal":{"id":1,"company_id":1}}`,
+		},
+	})
+}
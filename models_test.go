@@ -0,0 +1,99 @@
+package freee
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestAmountJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+	// 21億円を超える金額
+	const amount int64 = 3000000000
+	tests := []struct {
+		name  string
+		data  string
+		value func() interface{}
+		get   func(v interface{}) int64
+	}{
+		{
+			name:  "deal",
+			data:  `{"id":4000000000,"amount":3000000000,"details":[{"id":4000000001,"amount":3000000000,"vat":0,"entry_side":"debit"}],"payments":[{"id":4000000002,"amount":3000000000}]}`,
+			value: func() interface{} { return &Deal{} },
+			get: func(v interface{}) int64 {
+				d := v.(*Deal)
+				if d.ID != 4000000000 || (*d.Details)[0].Amount != d.Amount || (*d.Payments)[0].Amount != d.Amount {
+					t.Errorf("unexpected deal: %+v", d)
+				}
+				return d.Amount
+			},
+		},
+		{
+			name:  "invoice",
+			data:  `{"id":1,"total_amount":3000000000,"total_vat":300000000,"deal_id":4000000000,"total_amount_per_vat_rate":{"vat_10":-3000000000}}`,
+			value: func() interface{} { return &Invoice{} },
+			get: func(v interface{}) int64 {
+				i := v.(*Invoice)
+				if i.TotalAmountPerVatRate.Vat10 != -amount || *i.DealID != 4000000000 {
+					t.Errorf("unexpected invoice: %+v", i)
+				}
+				return i.TotalAmount
+			},
+		},
+		{
+			name:  "wallet txn",
+			data:  `{"id":1,"amount":3000000000,"due_amount":0,"balance":-3000000000}`,
+			value: func() interface{} { return &WalletTxn{} },
+			get: func(v interface{}) int64 {
+				w := v.(*WalletTxn)
+				if w.Balance != -amount {
+					t.Errorf("unexpected wallet txn: %+v", w)
+				}
+				return w.Amount
+			},
+		},
+		{
+			name:  "transfer",
+			data:  `{"id":1,"amount":3000000000}`,
+			value: func() interface{} { return &Transfer{} },
+			get:   func(v interface{}) int64 { return v.(*Transfer).Amount },
+		},
+		{
+			name:  "balance",
+			data:  `{"opening_balance":3000000000,"closing_balance":3000000000,"partners":[{"id":1,"closing_balance":3000000000}]}`,
+			value: func() interface{} { return &Balance{} },
+			get: func(v interface{}) int64 {
+				b := v.(*Balance)
+				if *b.OpeningBalance != amount || *(*b.Partners)[0].ClosingBalance != amount {
+					t.Errorf("unexpected balance: %+v", b)
+				}
+				return *b.ClosingBalance
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			v := tt.value()
+			if err := json.Unmarshal([]byte(tt.data), v); err != nil {
+				t.Fatal(err)
+			}
+			if got := tt.get(v); got != amount {
+				t.Fatalf("unexpected amount: %d", got)
+			}
+
+			b, err := json.Marshal(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w := tt.value()
+			if err := json.Unmarshal(b, w); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(v, w) {
+				t.Fatalf("unmatch round trip:\n%+v\n%+v", v, w)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package freee
+
+import (
+	"context"
+	"reflect"
+)
+
+// 一覧APIごとの limit の最大値
+const (
+	MaxLimitDeals                           = int32(100)
+	MaxLimitPartners                        = int32(3000)
+	MaxLimitManualJournals                  = int32(500)
+	MaxLimitWalletTxns                      = int32(100)
+	MaxLimitTransfers                       = int32(100)
+	MaxLimitInvoices                        = int32(100)
+	MaxLimitQuotations                      = int32(100)
+	MaxLimitItems                           = int32(3000)
+	MaxLimitTags                            = int32(3000)
+	MaxLimitBanks                           = int32(500)
+	MaxLimitReceipts                        = int32(3000)
+	MaxLimitSegmentTags                     = int32(500)
+	MaxLimitExpenseApplications             = int32(500)
+	MaxLimitExpenseApplicationLineTemplates = int32(100)
+	MaxLimitApprovalRequests                = int32(500)
+	MaxLimitPaymentRequests                 = int32(500)
+)
+
+// PageFunc fetches a single page starting at offset.
+// It returns the number of records on the page and the total number of
+// records reported by the endpoint (0 when the endpoint does not report it).
+type PageFunc func(ctx context.Context, offset, limit int32) (n int, totalCount int32, err error)
+
+// Pager walks every page of an offset/limit list endpoint.
+type Pager struct {
+	// 取得開始オフセット
+	Offset int32
+	// 1ページあたりの取得件数（0 または最大値を超える場合は最大値）
+	Limit int32
+	// 一覧APIの limit の最大値
+	MaxLimit int32
+}
+
+// NewPager returns a Pager for an endpoint whose limit is at most maxLimit.
+func NewPager(offset, limit, maxLimit int32) *Pager {
+	return &Pager{
+		Offset:   offset,
+		Limit:    limit,
+		MaxLimit: maxLimit,
+	}
+}
+
+func (p *Pager) pageSize() int32 {
+	if p.Limit <= 0 || (p.MaxLimit > 0 && p.Limit > p.MaxLimit) {
+		return p.MaxLimit
+	}
+	return p.Limit
+}
+
+// Each calls fn for each page until a short page is returned, the reported
+// total count is reached or ctx is cancelled.
+func (p *Pager) Each(ctx context.Context, fn PageFunc) error {
+	limit := p.pageSize()
+	offset := p.Offset
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		n, totalCount, err := fn(ctx, offset, limit)
+		if err != nil {
+			return err
+		}
+		offset += int32(n)
+		if n == 0 || (limit > 0 && int32(n) < limit) {
+			return nil
+		}
+		if totalCount > 0 && offset >= totalCount {
+			return nil
+		}
+	}
+}
+
+// collectPages walks every page with a Pager, and appends the records of each
+// page to the slice pointed to by out. fetch returns the records of the page
+// as a slice of the same type, and the total count as PageFunc does.
+func collectPages(ctx context.Context, offset, limit, maxLimit int32, out interface{}, fetch func(ctx context.Context, offset, limit int32) (records interface{}, totalCount int32, err error)) error {
+	dst := reflect.ValueOf(out).Elem()
+	return NewPager(offset, limit, maxLimit).Each(ctx, func(ctx context.Context, offset, limit int32) (int, int32, error) {
+		records, totalCount, err := fetch(ctx, offset, limit)
+		if err != nil {
+			return 0, 0, err
+		}
+		page := reflect.ValueOf(records)
+		dst.Set(reflect.AppendSlice(dst, page))
+		return page.Len(), totalCount, nil
+	})
+}
@@ -0,0 +1,92 @@
+package freee
+
+import (
+	"context"
+	"testing"
+)
+
+func TestPagerEach(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		pager      *Pager
+		records    int
+		totalCount int32
+		wantCalls  int
+		wantLimit  int32
+	}{
+		{"short page", NewPager(0, 0, 100), 250, 0, 3, 100},
+		{"exact pages", NewPager(0, 50, 100), 100, 0, 3, 50},
+		{"total count", NewPager(0, 50, 100), 100, 100, 2, 50},
+		{"limit over max", NewPager(0, 500, 100), 150, 0, 2, 100},
+		{"offset", NewPager(40, 20, 100), 100, 0, 4, 20},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			calls := 0
+			err := tt.pager.Each(context.Background(), func(ctx context.Context, offset, limit int32) (int, int32, error) {
+				calls++
+				if limit != tt.wantLimit {
+					t.Fatalf("unexpected limit: %d, %d", tt.wantLimit, limit)
+				}
+				n := tt.records - int(offset)
+				if n > int(limit) {
+					n = int(limit)
+				}
+				if n < 0 {
+					n = 0
+				}
+				return n, tt.totalCount, nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if calls != tt.wantCalls {
+				t.Fatalf("unmatch call nums : %d, %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
+
+func TestPagerEachCanceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := NewPager(0, 10, 100).Each(ctx, func(ctx context.Context, offset, limit int32) (int, int32, error) {
+		calls++
+		cancel()
+		return int(limit), 0, nil
+	})
+	if err != context.Canceled {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("unmatch call nums : %d", calls)
+	}
+}
+
+func TestCollectPages(t *testing.T) {
+	t.Parallel()
+	var got []int32
+	err := collectPages(context.Background(), 0, 2, 100, &got, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		var page []int32
+		for i := offset; i < offset+limit && i < 5; i++ {
+			page = append(page, i)
+		}
+		return page, 0, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []int32{0, 1, 2, 3, 4}
+	if len(got) != len(want) {
+		t.Fatalf("unmatch record nums : %d, %d", len(want), len(got))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("unexpected record: %d, %d", want[i], got[i])
+		}
+	}
+}
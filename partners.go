@@ -294,6 +294,24 @@ func (c *Client) GetPartners(ctx context.Context, reuseTokenSource oauth2.TokenS
 	return &result, nil
 }
 
+func (c *Client) GetAllPartners(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetPartnersOpts) ([]Partner, error) {
+	var partners []Partner
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitPartners, &partners, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetPartners(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Partners, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return partners, nil
+}
+
 func (c *Client) DestroyPartner(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, partnerID int32) error {
 	v, err := query.Values(nil)
 	if err != nil {
@@ -2,6 +2,7 @@ package freee
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"path"
 	"reflect"
@@ -12,6 +13,17 @@ import (
 
 const (
 	APIPathPaymentRequests = "payment_requests"
+
+	// 支払方法
+	PaymentMethodNone                 = "none"
+	PaymentMethodDomesticBankTransfer = "domestic_bank_transfer"
+	PaymentMethodAbroadBankTransfer   = "abroad_bank_transfer"
+	PaymentMethodAccountTransfer      = "account_transfer"
+	PaymentMethodCreditCard           = "credit_card"
+
+	// 支払依頼の明細行の種類
+	PaymentRequestLineTypeDealLine       = "deal_line"
+	PaymentRequestLineTypeWithholdingTax = "withholding_tax"
 )
 
 type GetPaymentRequestsOpts struct {
@@ -24,8 +36,8 @@ type GetPaymentRequestsOpts struct {
 	Title                string `url:"title,omitempty"`
 	ApplicantID          int32  `url:"applicant_id,omitempty"`
 	ApproverID           int32  `url:"approver_id,omitempty"`
-	MinAmount            int32  `url:"min_amount,omitempty"`
-	MaxAmount            int32  `url:"max_amount,omitempty"`
+	MinAmount            int64  `url:"min_amount,omitempty"`
+	MaxAmount            int64  `url:"max_amount,omitempty"`
 	PartnerID            int32  `url:"partner_id,omitempty"`
 	PartnerCode          string `url:"partner_code,omitempty"`
 	PaymentMethod        string `url:"payment_method,omitempty"`
@@ -36,6 +48,70 @@ type GetPaymentRequestsOpts struct {
 	Limit                int32  `url:"limit,omitempty"`
 }
 
+type PaymentRequestParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// 申請タイトル (250文字以内)
+	Title string `json:"title"`
+	// 申請日 (yyyy-mm-dd) 指定しない場合は当日の日付が登録されます
+	ApplicationDate *string `json:"application_date,omitempty"`
+	// 発生日 (yyyy-mm-dd)
+	IssueDate string `json:"issue_date"`
+	// 備考 (10000文字以内)
+	Description *string `json:"description,omitempty"`
+	// 支払期限 (yyyy-mm-dd)
+	PaymentDate *string `json:"payment_date,omitempty"`
+	// 支払方法(none: 指定なし, domestic_bank_transfer: 国内振込, abroad_bank_transfer: 国外振込, account_transfer: 口座振替, credit_card: クレジットカード)
+	PaymentMethod *string `json:"payment_method,omitempty"`
+	// 申請経路ID
+	ApprovalFlowRouteID int32 `json:"approval_flow_route_id"`
+	// 承認者のユーザーID (経路の最初のステップの承認方法が申請時に決定する場合は必須)
+	ApproverID *int32 `json:"approver_id,omitempty"`
+	// true: 下書き、false: 申請
+	Draft bool `json:"draft"`
+	// 請求書番号 (255文字以内)
+	DocumentCode *string `json:"document_code,omitempty"`
+	// 取引先ID
+	PartnerID *int32 `json:"partner_id,omitempty"`
+	// 取引先コード
+	PartnerCode *string `json:"partner_code,omitempty"`
+	// 証憑ファイルID（ファイルボックスのファイルID）（配列）
+	ReceiptIDs []int32 `json:"receipt_ids,omitempty"`
+	// 支払依頼の明細行一覧（配列）
+	PaymentRequestLines []PaymentRequestLineParams `json:"payment_request_lines"`
+	// 支払先口座 取引先の口座（Partner.BankAccountAttributes）を指定できます
+	*PartnerBankAccountAttributes
+}
+
+type PaymentRequestLineParams struct {
+	// 行の種類 (deal_line: 支払依頼の明細行, withholding_tax: 源泉所得税行)
+	LineType *string `json:"line_type,omitempty"`
+	// 内容 (250文字以内)
+	Description *string `json:"description,omitempty"`
+	// 金額
+	Amount int64 `json:"amount"`
+	// 勘定科目ID
+	AccountItemID *int32 `json:"account_item_id,omitempty"`
+	// 税区分コード
+	TaxCode *int32 `json:"tax_code,omitempty"`
+	// 品目ID
+	ItemID *int32 `json:"item_id,omitempty"`
+	// 部門ID
+	SectionID *int32 `json:"section_id,omitempty"`
+	// メモタグID
+	TagIDs []int32 `json:"tag_ids,omitempty"`
+	// セグメント１ID
+	Segment1TagID *int32 `json:"segment_1_tag_id,omitempty"`
+	// セグメント２ID
+	Segment2TagID *int32 `json:"segment_2_tag_id,omitempty"`
+	// セグメント３ID
+	Segment3TagID *int32 `json:"segment_3_tag_id,omitempty"`
+}
+
+type PaymentRequestResponse struct {
+	PaymentRequest PaymentRequest `json:"payment_request"`
+}
+
 type PaymentRequests struct {
 	PaymentRequests []PaymentRequest `json:"payment_requests"`
 }
@@ -50,17 +126,17 @@ type PaymentRequest struct {
 	// 申請日 (yyyy-mm-dd)
 	ApplicationDate string `json:"application_date"`
 	// 合計金額
-	TotalAmount int32 `json:"total_amount"`
+	TotalAmount int64 `json:"total_amount"`
 	// 申請ステータス(draft:下書き, in_progress:申請中, approved:承認済, rejected:却下, feedback:差戻し)
 	Status string `json:"status"`
 	// 取引ID (申請ステータス:statusがapprovedで、取引が存在する時のみdeal_idが表示されます)
-	DealID *int32 `json:"deal_id,omitempty"`
+	DealID *uint64 `json:"deal_id,omitempty"`
 	// 取引ステータス (申請ステータス:statusがapprovedで、取引が存在する時のみdeal_statusが表示されます settled:支払済み, unsettled:支払待ち)
 	DealStatus *string `json:"deal_status,omitempty"`
 	// 申請者のユーザーID
 	ApplicantID int32 `json:"applicant_id"`
 	// 承認者（配列） 承認ステップのresource_typeがunspecified (指定なし)の場合はapproversはレスポンスに含まれません。 しかし、resource_typeがunspecifiedの承認ステップにおいて誰かが承認・却下・差し戻しのいずれかのアクションを取った後は、 approversはレスポンスに含まれるようになります。 その場合approversにはアクションを行ったステップのIDとアクションを行ったユーザーのIDが含まれます。
-	Approvers Approver `json:"approvers"`
+	Approvers []Approver `json:"approvers"`
 	// 申請No.
 	ApplicationNumber string `json:"application_number"`
 	// 現在承認ステップID
@@ -81,6 +157,41 @@ type PaymentRequest struct {
 	PartnerCode string `json:"partner_code"`
 	// 取引先名
 	PartnerName string `json:"partner_name"`
+	// 申請経路ID (支払依頼の取得時のみ含まれる)
+	ApprovalFlowRouteID *int32 `json:"approval_flow_route_id,omitempty"`
+	// 備考 (支払依頼の取得時のみ含まれる)
+	Description *string `json:"description,omitempty"`
+	// 証憑ファイルID（配列）(支払依頼の取得時のみ含まれる)
+	ReceiptIDs *[]int32 `json:"receipt_ids,omitempty"`
+	// 支払依頼の明細行一覧（配列）(支払依頼の取得時のみ含まれる)
+	PaymentRequestLines *[]PaymentRequestLine `json:"payment_request_lines,omitempty"`
+}
+
+type PaymentRequestLine struct {
+	// 支払依頼の明細行ID
+	ID int32 `json:"id"`
+	// 行の種類 (deal_line: 支払依頼の明細行, withholding_tax: 源泉所得税行)
+	LineType string `json:"line_type"`
+	// 内容
+	Description string `json:"description"`
+	// 金額
+	Amount int64 `json:"amount"`
+	// 勘定科目ID
+	AccountItemID *int32 `json:"account_item_id,omitempty"`
+	// 税区分コード
+	TaxCode *int32 `json:"tax_code,omitempty"`
+	// 品目ID
+	ItemID *int32 `json:"item_id,omitempty"`
+	// 部門ID
+	SectionID *int32 `json:"section_id,omitempty"`
+	// メモタグID
+	TagIDs []int32 `json:"tag_ids,omitempty"`
+	// セグメント１ID
+	Segment1TagID *int32 `json:"segment_1_tag_id,omitempty"`
+	// セグメント２ID
+	Segment2TagID *int32 `json:"segment_2_tag_id,omitempty"`
+	// セグメント３ID
+	Segment3TagID *int32 `json:"segment_3_tag_id,omitempty"`
 }
 
 type Approver struct {
@@ -113,6 +224,95 @@ func (c *Client) GetPaymentRequests(ctx context.Context, reuseTokenSource oauth2
 	return &result, nil
 }
 
+func (c *Client) GetAllPaymentRequests(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetPaymentRequestsOpts) ([]PaymentRequest, error) {
+	var requests []PaymentRequest
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitPaymentRequests, &requests, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetPaymentRequests(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.PaymentRequests, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+func (c *Client) GetPaymentRequest(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, paymentRequestID int32) (*PaymentRequest, error) {
+	var result PaymentRequestResponse
+
+	v, err := query.Values(nil)
+	if err != nil {
+		return nil, err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathPaymentRequests, fmt.Sprint(paymentRequestID)), http.MethodGet, reuseTokenSource, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.PaymentRequest, nil
+}
+
+func (c *Client) CreatePaymentRequest(ctx context.Context, reuseTokenSource oauth2.TokenSource, params PaymentRequestParams) (*PaymentRequest, error) {
+	var result PaymentRequestResponse
+	err := c.call(ctx, APIPathPaymentRequests, http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.PaymentRequest, nil
+}
+
+func (c *Client) UpdatePaymentRequest(ctx context.Context, reuseTokenSource oauth2.TokenSource, paymentRequestID int32, params PaymentRequestParams) (*PaymentRequest, error) {
+	var result PaymentRequestResponse
+	err := c.call(ctx, path.Join(APIPathPaymentRequests, fmt.Sprint(paymentRequestID)), http.MethodPut, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.PaymentRequest, nil
+}
+
+func (c *Client) DestroyPaymentRequest(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, paymentRequestID int32) error {
+	v, err := query.Values(nil)
+	if err != nil {
+		return err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathPaymentRequests, fmt.Sprint(paymentRequestID)), http.MethodDelete, reuseTokenSource, v, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ActionPaymentRequest approves, rejects, sends back or withdraws (cancel) the payment request.
+func (c *Client) ActionPaymentRequest(ctx context.Context, reuseTokenSource oauth2.TokenSource, paymentRequestID int32, params ApprovalActionParams) (*PaymentRequest, error) {
+	var result PaymentRequestResponse
+	err := c.call(ctx, path.Join(APIPathPaymentRequests, fmt.Sprint(paymentRequestID), APIPathActions), http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.PaymentRequest, nil
+}
+
+// ActionParams returns the params of the approval action on the current step and round.
+func (r *PaymentRequest) ActionParams(approvalAction string) (*ApprovalActionParams, error) {
+	if r.CurrentStepID == 0 {
+		return nil, fmt.Errorf("payment request %d is not in progress", r.ID)
+	}
+	return &ApprovalActionParams{
+		CompanyID:      r.CompanyID,
+		ApprovalAction: approvalAction,
+		TargetStepID:   r.CurrentStepID,
+		TargetRound:    r.CurrentRound,
+	}, nil
+}
+
 func (s *Client) GetPaymentRequestOrderList() []string {
 	str := new(PaymentRequest)
 
@@ -0,0 +1,153 @@
+package freee
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestPaymentRequestParamsJSON(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		params PaymentRequestParams
+		want   string
+	}{
+		{
+			name: "without bank account",
+			params: PaymentRequestParams{
+				CompanyID:           1,
+				Title:               "外注費",
+				IssueDate:           "2021-06-01",
+				ApprovalFlowRouteID: 2,
+				PaymentRequestLines: []PaymentRequestLineParams{{Amount: 1000}},
+			},
+			want: `{"company_id":1,"title":"外注費","issue_date":"2021-06-01","approval_flow_route_id":2,"draft":false,"payment_request_lines":[{"amount":1000}]}`,
+		},
+		{
+			name: "bank account is flattened",
+			params: PaymentRequestParams{
+				CompanyID:           1,
+				Title:               "外注費",
+				IssueDate:           "2021-06-01",
+				ApprovalFlowRouteID: 2,
+				Draft:               true,
+				ReceiptIDs:          []int32{3, 4},
+				PaymentRequestLines: []PaymentRequestLineParams{{Amount: 1000}},
+				PartnerBankAccountAttributes: &PartnerBankAccountAttributes{
+					BankCode:      stringPtr("0001"),
+					AccountNumber: stringPtr("1234567"),
+				},
+			},
+			want: `{"company_id":1,"title":"外注費","issue_date":"2021-06-01","approval_flow_route_id":2,"draft":true,"receipt_ids":[3,4],"payment_request_lines":[{"amount":1000}],"bank_code":"0001","account_number":"1234567"}`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			b, err := json.Marshal(tt.params)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.want {
+				t.Fatalf("unexpected body:\n%s\n%s", tt.want, b)
+			}
+		})
+	}
+}
+
+func TestPaymentRequestEndpoints(t *testing.T) {
+	t.Parallel()
+	// 承認者は配列で返される
+	request := `{"payment_request":{"id":10,"company_id":1,"title":"外注費","issue_date":"2021-06-01","status":"in_progress",
+		"approvers":[{"step_id":5,"user_id":7,"status":"initial","is_force_action":false,"resource_type":"predefined_user"},
+			{"step_id":6,"user_id":8,"status":"initial","is_force_action":false,"resource_type":"predefined_user"}],
+		"current_step_id":5,"current_round":1,"payment_request_lines":[{"id":1,"amount":1000}]}}`
+	params := PaymentRequestParams{
+		CompanyID:           1,
+		Title:               "外注費",
+		IssueDate:           "2021-06-01",
+		ApprovalFlowRouteID: 2,
+		PaymentRequestLines: []PaymentRequestLineParams{{Amount: 1000}},
+	}
+	body := `{"company_id":1,"title":"外注費","issue_date":"2021-06-01","approval_flow_route_id":2,"draft":false,"payment_request_lines":[{"amount":1000}]}`
+	check := func(got *PaymentRequest) error {
+		if got.ID != 10 || len(got.Approvers) != 2 || got.Approvers[1].UserID != 8 {
+			return fmt.Errorf("unexpected payment request: %+v", got)
+		}
+		return nil
+	}
+
+	current := &PaymentRequest{ID: 10, CompanyID: 1, CurrentStepID: 5, CurrentRound: 1}
+	action, err := current.ActionParams(ApprovalActionApprove)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := (&PaymentRequest{ID: 11}).ActionParams(ApprovalActionApprove); err == nil {
+		t.Fatal("action on the request which is not in progress is accepted")
+	}
+
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "get",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.GetPaymentRequest(ctx, ts, 1, 10)
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodGet, path: "/api/1/payment_requests/10", query: "company_id=1",
+			response: request,
+		},
+		{
+			name: "create",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.CreatePaymentRequest(ctx, ts, params)
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodPost, path: "/api/1/payment_requests",
+			body: body, response: request,
+		},
+		{
+			name: "update",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.UpdatePaymentRequest(ctx, ts, 10, params)
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodPut, path: "/api/1/payment_requests/10",
+			body: body, response: request,
+		},
+		{
+			name: "destroy",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				return c.DestroyPaymentRequest(ctx, ts, 1, 10)
+			},
+			method: http.MethodDelete, path: "/api/1/payment_requests/10", query: "company_id=1",
+		},
+		{
+			name: "action",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.ActionPaymentRequest(ctx, ts, 10, *action)
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodPost, path: "/api/1/payment_requests/10/actions",
+			body:     `{"company_id":1,"approval_action":"approve","target_step_id":5,"target_round":1}`,
+			response: request,
+		},
+	})
+}
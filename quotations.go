@@ -2,9 +2,11 @@ package freee
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"path"
 	"reflect"
+	"strconv"
 
 	"github.com/google/go-querystring/query"
 	"golang.org/x/oauth2"
@@ -12,6 +14,9 @@ import (
 
 const (
 	APIPathQuotations = "quotations"
+
+	QuotationStatusUnsubmitted = "unsubmitted"
+	QuotationStatusSubmitted   = "submitted"
 )
 
 type GetQuotationsOpts struct {
@@ -30,6 +35,10 @@ type Quotations struct {
 	Quotations []Quotation `json:"quotations"`
 }
 
+type QuotationResponse struct {
+	Quotation Quotation `json:"quotation"`
+}
+
 type Quotation struct {
 	// 見積書ID
 	ID int32 `json:"id"`
@@ -46,11 +55,11 @@ type Quotation struct {
 	// タイトル
 	Title *string `json:"title,omitempty"`
 	// 合計金額
-	TotalAmount int32 `json:"total_amount"`
+	TotalAmount int64 `json:"total_amount"`
 	// 消費税
-	TotalVat *int32 `json:"total_vat,omitempty"`
+	TotalVat *int64 `json:"total_vat,omitempty"`
 	// 小計
-	SubTotal *int32 `json:"sub_total,omitempty"`
+	SubTotal *int64 `json:"sub_total,omitempty"`
 	// 概要
 	Description *string `json:"description,omitempty"`
 	// 見積書ステータス (unsubmitted: 送付待ち, submitted: 送付済み, all: 全て)
@@ -123,9 +132,9 @@ type QuotationContent struct {
 	// 単価
 	UnitPrice float64 `json:"unit_price"`
 	// 内税/外税の判別とamountの税込み、税抜きについて
-	Amount int32 `json:"amount"`
+	Amount int64 `json:"amount"`
 	// 消費税額
-	Vat int32 `json:"vat"`
+	Vat int64 `json:"vat"`
 	// 軽減税率税区分（true: 対象、false: 対象外）
 	ReducedVat bool `json:"reduced_vat"`
 	// 備考
@@ -164,13 +173,105 @@ type QuotationContent struct {
 
 type TotalAmountPerVatRatetotalAmountPerVatRate struct {
 	// 税率5%の税込み金額合計
-	Vat5 uint64 `json:"vat_5"`
+	Vat5 int64 `json:"vat_5"`
 	// 税率8%の税込み金額合計
-	Vat8 uint64 `json:"vat_8"`
+	Vat8 int64 `json:"vat_8"`
 	// 軽減税率8%の税込み金額合計
-	ReducedVat8 uint64 `json:"reduced_vat_8"`
+	ReducedVat8 int64 `json:"reduced_vat_8"`
 	// 税率10%の税込み金額合計
-	Vat10 uint64 `json:"vat_10"`
+	Vat10 int64 `json:"vat_10"`
+}
+
+type QuotationParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// 見積日 (yyyy-mm-dd)
+	IssueDate *string `json:"issue_date,omitempty"`
+	// 取引先ID
+	PartnerID *int32 `json:"partner_id,omitempty"`
+	// 取引先コード
+	PartnerCode *string `json:"partner_code,omitempty"`
+	// 見積書番号 (デフォルト: 自動採番されます)
+	QuotationNumber *string `json:"quotation_number,omitempty"`
+	// タイトル
+	Title *string `json:"title,omitempty"`
+	// 概要
+	Description *string `json:"description,omitempty"`
+	// 見積書ステータス (unsubmitted: 送付待ち, submitted: 送付済み)
+	QuotationStatus *string `json:"quotation_status,omitempty"`
+	// 見積書に表示する取引先名
+	PartnerDisplayName *string `json:"partner_display_name,omitempty"`
+	// 敬称（御中、様、(空白)の3つから選択）
+	PartnerTitle *string `json:"partner_title,omitempty"`
+	// 取引先担当者名
+	PartnerContactInfo *string `json:"partner_contact_info,omitempty"`
+	// 取引先郵便番号
+	PartnerZipcode *string `json:"partner_zipcode,omitempty"`
+	// 取引先都道府県コード（-1: 設定しない、0:北海道 ... 46:沖縄）
+	PartnerPrefectureCode *int32 `json:"partner_prefecture_code,omitempty"`
+	// 取引先市区町村・番地
+	PartnerAddress1 *string `json:"partner_address1,omitempty"`
+	// 取引先建物名・部屋番号など
+	PartnerAddress2 *string `json:"partner_address2,omitempty"`
+	// 事業所名
+	CompanyName *string `json:"company_name,omitempty"`
+	// 郵便番号
+	CompanyZipcode *string `json:"company_zipcode,omitempty"`
+	// 都道府県コード（-1: 設定しない、0:北海道 ... 46:沖縄）
+	CompanyPrefectureCode *int32 `json:"company_prefecture_code,omitempty"`
+	// 市区町村・番地
+	CompanyAddress1 *string `json:"company_address1,omitempty"`
+	// 建物名・部屋番号など
+	CompanyAddress2 *string `json:"company_address2,omitempty"`
+	// 事業所担当者名
+	CompanyContactInfo *string `json:"company_contact_info,omitempty"`
+	// メッセージ
+	Message *string `json:"message,omitempty"`
+	// 備考
+	Notes *string `json:"notes,omitempty"`
+	// 見積書レイアウト
+	QuotationLayout *string `json:"quotation_layout,omitempty"`
+	// 見積書の消費税計算方法(inclusive: 内税, exclusive: 外税)
+	TaxEntryMethod *string `json:"tax_entry_method,omitempty"`
+	// 見積内容
+	QuotationContents *[]QuotationContentParams `json:"quotation_contents,omitempty"`
+}
+
+type QuotationContentParams struct {
+	// 見積内容ID: 既存の見積内容を更新する場合に指定します。IDを指定しない見積内容は、新規行として扱われ追加されます。
+	ID *int32 `json:"id,omitempty"`
+	// 順序
+	Order int32 `json:"order"`
+	// 行の種類 (normal: 通常, discount: 割引, text: テキスト)
+	Type string `json:"type"`
+	// 数量
+	Qty *float64 `json:"qty,omitempty"`
+	// 単位
+	Unit *string `json:"unit,omitempty"`
+	// 単価 (行の種類:normal, discountの時のみ必須)
+	UnitPrice *float64 `json:"unit_price,omitempty"`
+	// 消費税額
+	Vat *int64 `json:"vat,omitempty"`
+	// 軽減税率税区分（true: 対象、false: 対象外）
+	ReducedVat *bool `json:"reduced_vat,omitempty"`
+	// 備考
+	Description *string `json:"description,omitempty"`
+	// 勘定科目ID
+	AccountItemID *int32 `json:"account_item_id,omitempty"`
+	// 税区分コード
+	TaxCode *int32 `json:"tax_code,omitempty"`
+	// 品目ID
+	ItemID *int32 `json:"item_id,omitempty"`
+	// 部門ID
+	SectionID *int32 `json:"section_id,omitempty"`
+	// メモタグID
+	TagIDs *[]int32 `json:"tag_ids,omitempty"`
+	// セグメント１ID
+	Segment1TagID *int32 `json:"segment_1_tag_id,omitempty"`
+	// セグメント２ID
+	Segment2TagID *int32 `json:"segment_2_tag_id,omitempty"`
+	// セグメント３ID
+	Segment3TagID *int32 `json:"segment_3_tag_id,omitempty"`
 }
 
 func (c *Client) GetQuotations(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*Quotations, error) {
@@ -190,6 +291,175 @@ func (c *Client) GetQuotations(ctx context.Context, reuseTokenSource oauth2.Toke
 	return &result, nil
 }
 
+func (c *Client) GetAllQuotations(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetQuotationsOpts) ([]Quotation, error) {
+	var quotations []Quotation
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitQuotations, &quotations, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetQuotations(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Quotations, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return quotations, nil
+}
+
+func (c *Client) GetQuotation(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, quotationID int32) (*Quotation, error) {
+	var result QuotationResponse
+
+	v, err := query.Values(nil)
+	if err != nil {
+		return nil, err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathQuotations, fmt.Sprint(quotationID)), http.MethodGet, reuseTokenSource, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Quotation, nil
+}
+
+func (c *Client) CreateQuotation(ctx context.Context, reuseTokenSource oauth2.TokenSource, params QuotationParams) (*Quotation, error) {
+	var result QuotationResponse
+	err := c.call(ctx, APIPathQuotations, http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Quotation, nil
+}
+
+// UpdateQuotation updates the quotation. Quotation contents which are not included in
+// params.QuotationContents are deleted, so specify the IDs of the contents to keep.
+func (c *Client) UpdateQuotation(ctx context.Context, reuseTokenSource oauth2.TokenSource, quotationID int32, params QuotationParams) (*Quotation, error) {
+	var result QuotationResponse
+	err := c.call(ctx, path.Join(APIPathQuotations, fmt.Sprint(quotationID)), http.MethodPut, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Quotation, nil
+}
+
+func (c *Client) DestroyQuotation(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, quotationID int32) error {
+	v, err := query.Values(nil)
+	if err != nil {
+		return err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathQuotations, fmt.Sprint(quotationID)), http.MethodDelete, reuseTokenSource, v, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InvoiceParams builds the params to create a draft invoice from the quotation.
+// The partner, company and contents of the quotation are copied, and
+// issueDate and dueDate (yyyy-mm-dd) are used for the invoice.
+// freee has no accepted status for quotations, so only quotations which have
+// been submitted (送付済み) to the partner are converted.
+// The params do not share memory with the quotation.
+func (q *Quotation) InvoiceParams(issueDate, dueDate string) (*InvoiceParams, error) {
+	if q.QuotationStatus != QuotationStatusSubmitted {
+		return nil, fmt.Errorf("quotation %d is not submitted: %q", q.ID, q.QuotationStatus)
+	}
+	params := &InvoiceParams{
+		CompanyID:             q.CompanyID,
+		IssueDate:             &issueDate,
+		Title:                 cloneString(q.Title),
+		Description:           cloneString(q.Description),
+		InvoiceStatus:         stringPtr(InvoiceStatusDraft),
+		PartnerDisplayName:    cloneString(q.PartnerDisplayName),
+		PartnerTitle:          stringPtr(q.PartnerTitle),
+		PartnerContactInfo:    cloneString(q.PartnerContactInfo),
+		PartnerZipcode:        cloneString(q.PartnerZipcode),
+		PartnerPrefectureCode: cloneInt32(q.PartnerPrefectureCode),
+		PartnerAddress1:       cloneString(q.PartnerAddress1),
+		PartnerAddress2:       cloneString(q.PartnerAddress2),
+		CompanyName:           stringPtr(q.CompanyName),
+		CompanyZipcode:        cloneString(q.CompanyZipcode),
+		CompanyPrefectureCode: cloneInt32(q.CompanyPrefectureCode),
+		CompanyAddress1:       cloneString(q.CompanyAddress1),
+		CompanyAddress2:       cloneString(q.CompanyAddress2),
+		CompanyContactInfo:    cloneString(q.CompanyContactInfo),
+		Message:               cloneString(q.Message),
+		Notes:                 cloneString(q.Notes),
+		TaxEntryMethod:        stringPtr(q.TaxEntryMethod),
+	}
+	if dueDate != "" {
+		params.DueDate = &dueDate
+	}
+	// 取引先は ID と コードのどちらか一方のみ指定する
+	if q.PartnerID != 0 {
+		params.PartnerID = nonZeroInt32(q.PartnerID)
+	} else {
+		params.PartnerCode = cloneString(q.PartnerCode)
+	}
+	if q.QuotationLayout != "" {
+		params.InvoiceLayout = stringPtr(q.QuotationLayout)
+	}
+
+	if q.QuotationContents != nil {
+		contents := make([]InvoiceContentParams, 0, len(*q.QuotationContents))
+		for _, qc := range *q.QuotationContents {
+			qc := qc
+			content := InvoiceContentParams{
+				Order:       qc.Order,
+				Type:        qc.Type,
+				Description: &qc.Description,
+			}
+			if qc.Type != InvoiceContentTypeText {
+				content.Qty = &qc.Qty
+				content.Unit = &qc.Unit
+				content.UnitPrice = &qc.UnitPrice
+				content.Vat = &qc.Vat
+				content.ReducedVat = &qc.ReducedVat
+			}
+			content.AccountItemID = nonZeroInt32(qc.AccountItemID)
+			content.TaxCode = nonZeroInt32(qc.TaxCode)
+			content.ItemID = nonZeroInt32(qc.ItemID)
+			content.SectionID = nonZeroInt32(qc.SectionID)
+			if len(qc.TagIDs) > 0 {
+				tagIDs := append([]int32(nil), qc.TagIDs...)
+				content.TagIDs = &tagIDs
+			}
+			var err error
+			for _, segment := range []struct {
+				from *string
+				to   **int32
+			}{
+				{qc.Segment1TagID, &content.Segment1TagID},
+				{qc.Segment2TagID, &content.Segment2TagID},
+				{qc.Segment3TagID, &content.Segment3TagID},
+			} {
+				if *segment.to, err = parseSegmentTagID(segment.from); err != nil {
+					return nil, fmt.Errorf("quotation content %d: %w", qc.ID, err)
+				}
+			}
+			contents = append(contents, content)
+		}
+		params.InvoiceContents = &contents
+	}
+	return params, nil
+}
+
+func parseSegmentTagID(s *string) (*int32, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	id, err := strconv.ParseInt(*s, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid segment tag id %q", *s)
+	}
+	v := int32(id)
+	return &v, nil
+}
+
 func (s *Client) GetQuotationOrderList() []string {
 	str := new(Quotation)
 
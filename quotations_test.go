@@ -0,0 +1,76 @@
+package freee
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQuotationInvoiceParams(t *testing.T) {
+	t.Parallel()
+	segment := "12"
+	q := &Quotation{
+		CompanyID:       1,
+		PartnerID:       2,
+		PartnerCode:     stringPtr("partner-a"),
+		QuotationStatus: QuotationStatusSubmitted,
+		PartnerTitle:    "御中",
+		CompanyName:     "freee株式会社",
+		QuotationLayout: "default_classic",
+		TaxEntryMethod:  TaxEntryMethodExclusive,
+		QuotationContents: &[]QuotationContent{
+			{ID: 1, Order: 0, Type: InvoiceContentTypeNormal, Qty: 2, Unit: "個", UnitPrice: 1500, Amount: 3000, Vat: 300, Description: "商品A", AccountItemID: 10, TaxCode: 129, TagIDs: []int32{3}, Segment1TagID: &segment},
+			{ID: 2, Order: 1, Type: InvoiceContentTypeText, Description: "備考"},
+		},
+	}
+	params, err := q.InvoiceParams("2021-07-01", "2021-07-31")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *params.IssueDate != "2021-07-01" || *params.DueDate != "2021-07-31" || *params.PartnerID != 2 || *params.InvoiceStatus != InvoiceStatusDraft || *params.InvoiceLayout != "default_classic" {
+		t.Fatalf("unexpected params: %+v", params)
+	}
+	if params.PartnerCode != nil {
+		t.Fatalf("unexpected partner code: %s", *params.PartnerCode)
+	}
+	contents := *params.InvoiceContents
+	if len(contents) != 2 {
+		t.Fatalf("unmatch content nums : %d", len(contents))
+	}
+	normal := contents[0]
+	if normal.ID != nil || *normal.Qty != 2 || *normal.UnitPrice != 1500 || *normal.Vat != 300 || *normal.AccountItemID != 10 || *normal.TaxCode != 129 || *normal.Segment1TagID != 12 || normal.ItemID != nil || !reflect.DeepEqual(*normal.TagIDs, []int32{3}) {
+		t.Fatalf("unexpected content: %+v", normal)
+	}
+	text := contents[1]
+	if text.Qty != nil || text.UnitPrice != nil || text.AccountItemID != nil || *text.Description != "備考" {
+		t.Fatalf("unexpected content: %+v", text)
+	}
+
+	*params.PartnerTitle = "様"
+	*params.CompanyName = "changed"
+	*params.PartnerID = 3
+	(*normal.TagIDs)[0] = 4
+	if q.PartnerTitle != "御中" || q.CompanyName != "freee株式会社" || q.PartnerID != 2 || (*q.QuotationContents)[0].TagIDs[0] != 3 {
+		t.Fatalf("quotation is modified by params: %+v", q)
+	}
+
+	q.PartnerID = 0
+	params, err = q.InvoiceParams("2021-07-01", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.PartnerID != nil || *params.PartnerCode != "partner-a" {
+		t.Fatalf("unexpected partner: %+v", params)
+	}
+
+	q.QuotationStatus = QuotationStatusUnsubmitted
+	if _, err := q.InvoiceParams("2021-07-01", ""); err == nil {
+		t.Fatal("unsubmitted quotation is accepted")
+	}
+	q.QuotationStatus = QuotationStatusSubmitted
+
+	invalid := "abc"
+	(*q.QuotationContents)[0].Segment1TagID = &invalid
+	if _, err := q.InvoiceParams("2021-07-01", ""); err == nil {
+		t.Fatal("invalid segment tag id is accepted")
+	}
+}
@@ -3,6 +3,7 @@ package freee
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"path"
 	"reflect"
@@ -12,7 +13,8 @@ import (
 )
 
 const (
-	APIPathReceipts = "receipts"
+	APIPathReceipts        = "receipts"
+	APIPathReceiptDownload = "download"
 )
 
 type CreateReceiptParams struct {
@@ -26,6 +28,26 @@ type CreateReceiptParams struct {
 	Receipt []byte `json:"receipt"`
 }
 
+type UpdateReceiptParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// メモ (255文字以内)
+	Description *string `json:"description,omitempty"`
+	// 取引日 (yyyy-mm-dd)
+	IssueDate string `json:"issue_date"`
+	// 証憑のメタデータ
+	ReceiptMetadatum *ReceiptMetadatumParams `json:"receipt_metadatum,omitempty"`
+}
+
+type ReceiptMetadatumParams struct {
+	// 発行元
+	PartnerName *string `json:"partner_name,omitempty"`
+	// 発行日 (yyyy-mm-dd)
+	IssueDate *string `json:"issue_date,omitempty"`
+	// 金額
+	Amount *int64 `json:"amount,omitempty"`
+}
+
 type Receipts struct {
 	Receipts []Receipt `json:"receipts"`
 }
@@ -91,6 +113,55 @@ func (c *Client) CreateReceipt(ctx context.Context, reuseTokenSource oauth2.Toke
 	return &result, nil
 }
 
+// CreateReceiptFromReader uploads a receipt file read from r without buffering it.
+// params.Receipt is ignored. contentType is the MIME type of the file, such as image/jpeg or application/pdf.
+func (c *Client) CreateReceiptFromReader(ctx context.Context, reuseTokenSource oauth2.TokenSource, params CreateReceiptParams, receiptName string, contentType string, r io.Reader) (*ReceiptResponse, error) {
+	postBody := map[string]string{
+		"company_id":  fmt.Sprint(params.CompanyID),
+		"description": params.Description,
+		"issue_date":  params.IssueDate,
+	}
+	var result ReceiptResponse
+	err := c.postFileStream(ctx, APIPathReceipts, http.MethodPost, reuseTokenSource, nil, postBody, receiptName, contentType, r, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) UpdateReceipt(ctx context.Context, reuseTokenSource oauth2.TokenSource, receiptID int32, params UpdateReceiptParams) (*ReceiptResponse, error) {
+	var result ReceiptResponse
+	err := c.call(ctx, path.Join(APIPathReceipts, fmt.Sprint(receiptID)), http.MethodPut, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) DestroyReceipt(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, receiptID int32) error {
+	v, err := query.Values(nil)
+	if err != nil {
+		return err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathReceipts, fmt.Sprint(receiptID)), http.MethodDelete, reuseTokenSource, v, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DownloadReceipt writes the original file of the receipt to w.
+func (c *Client) DownloadReceipt(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, receiptID int32, w io.Writer) error {
+	v, err := query.Values(nil)
+	if err != nil {
+		return err
+	}
+	SetCompanyID(&v, companyID)
+	return c.call(ctx, path.Join(APIPathReceipts, fmt.Sprint(receiptID), APIPathReceiptDownload), http.MethodGet, reuseTokenSource, v, nil, w)
+}
+
 func (c *Client) GetReceipt(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, receiptID int32) (*ReceiptResponse, error) {
 	var result ReceiptResponse
 
@@ -122,6 +193,24 @@ func (c *Client) GetReceipts(ctx context.Context, reuseTokenSource oauth2.TokenS
 	return &result, nil
 }
 
+func (c *Client) GetAllReceipts(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetReceiptOpts) ([]Receipt, error) {
+	var receipts []Receipt
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitReceipts, &receipts, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetReceipts(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Recipts, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return receipts, nil
+}
+
 func (s *Client) GetReceiptOrderList() []string {
 	str := new(Receipt)
 
@@ -0,0 +1,100 @@
+package freee
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestCreateReceiptFromReader(t *testing.T) {
+	t.Parallel()
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Error(err)
+			return
+		}
+		if r.FormValue("company_id") != "1" || r.FormValue("issue_date") != "2021-06-01" {
+			t.Errorf("unexpected form: %v", r.MultipartForm.Value)
+		}
+		f, h, err := r.FormFile("receipt")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer f.Close()
+		b, _ := ioutil.ReadAll(f)
+		if h.Filename != "scan.pdf" || h.Header.Get("Content-Type") != "application/pdf" || string(b) != "%PDF-1.4" {
+			t.Errorf("unexpected file: %s %v %q", h.Filename, h.Header, b)
+		}
+		fmt.Fprint(w, `{"receipt":{"id":10,"mime_type":"application/pdf"}}`)
+	})
+
+	params := CreateReceiptParams{CompanyID: 1, IssueDate: "2021-06-01"}
+	result, err := client.CreateReceiptFromReader(context.Background(), ts, params, "scan.pdf", "application/pdf", strings.NewReader("%PDF-1.4"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Receipt.ID != 10 {
+		t.Fatalf("unexpected receipt: %+v", result.Receipt)
+	}
+}
+
+func TestDownloadReceipt(t *testing.T) {
+	t.Parallel()
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/1/receipts/10/download" || r.URL.Query().Get("company_id") != "1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "image/png")
+		fmt.Fprint(w, "\x89PNG")
+	})
+
+	var buf bytes.Buffer
+	if err := client.DownloadReceipt(context.Background(), ts, 1, 10, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "\x89PNG" {
+		t.Fatalf("unexpected file: %q", buf.String())
+	}
+}
+
+func TestReceiptEndpoints(t *testing.T) {
+	t.Parallel()
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "update",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.UpdateReceipt(ctx, ts, 10, UpdateReceiptParams{
+					CompanyID:        1,
+					Description:      stringPtr("領収書"),
+					IssueDate:        "2021-06-01",
+					ReceiptMetadatum: &ReceiptMetadatumParams{PartnerName: stringPtr("freee株式会社")},
+				})
+				if err != nil {
+					return err
+				}
+				if got.Receipt.ID != 10 {
+					return fmt.Errorf("unexpected receipt: %+v", got.Receipt)
+				}
+				return nil
+			},
+			method: http.MethodPut, path: "/api/1/receipts/10",
+			body:     `{"company_id":1,"description":"領収書","issue_date":"2021-06-01","receipt_metadatum":{"partner_name":"freee株式会社"}}`,
+			response: `{"receipt":{"id":10,"status":"unconfirmed"}}`,
+		},
+		{
+			name: "destroy",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				return c.DestroyReceipt(ctx, ts, 1, 10)
+			},
+			method: http.MethodDelete, path: "/api/1/receipts/10", query: "company_id=1",
+		},
+	})
+}
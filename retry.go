@@ -0,0 +1,109 @@
+package freee
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultRetryMaxAttempts    = 3
+	DefaultRetryInitialBackoff = 1 * time.Second
+	DefaultRetryMaxBackoff     = 30 * time.Second
+)
+
+// RetryPolicy is a setting for retrying requests which failed with
+// 429 Too Many Requests or 5xx responses.
+type RetryPolicy struct {
+	// 最大試行回数（初回の試行を含む）
+	MaxAttempts int
+	// 初回リトライまでの待機時間。以降は試行ごとに倍になります。
+	InitialBackoff time.Duration
+	// 待機時間の上限
+	MaxBackoff time.Duration
+	// POST リクエストもリトライする（デフォルトでは冪等なメソッドのみリトライします）
+	RetryPost bool
+}
+
+// NewRetryPolicy returns a RetryPolicy with default settings.
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    DefaultRetryMaxAttempts,
+		InitialBackoff: DefaultRetryInitialBackoff,
+		MaxBackoff:     DefaultRetryMaxBackoff,
+	}
+}
+
+func (p *RetryPolicy) retryable(method string, statusCode int, attempt int) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if statusCode != http.StatusTooManyRequests && statusCode < http.StatusInternalServerError {
+		return false
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return p.RetryPost
+	}
+	return false
+}
+
+// backoff returns the duration to wait before the next attempt.
+// The Retry-After header takes precedence over the exponential backoff.
+func (p *RetryPolicy) backoff(attempt int, retryAfter string) time.Duration {
+	if d, ok := parseRetryAfter(retryAfter); ok {
+		if p.MaxBackoff > 0 && d > p.MaxBackoff {
+			return p.MaxBackoff
+		}
+		return d
+	}
+	d := p.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		d *= 2
+		if p.MaxBackoff > 0 && d > p.MaxBackoff {
+			d = p.MaxBackoff
+			break
+		}
+	}
+	if d <= 0 {
+		return 0
+	}
+	// equal jitter
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if sec, err := strconv.Atoi(v); err == nil {
+		if sec < 0 {
+			return 0, false
+		}
+		return time.Duration(sec) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
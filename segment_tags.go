@@ -73,6 +73,24 @@ func (c *Client) GetSegmentTags(ctx context.Context, reuseTokenSource oauth2.Tok
 	return &result, nil
 }
 
+func (c *Client) GetAllSegmentTags(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, segmentID int32, opts GetSegmentTagsOpts) ([]SegmentTag, error) {
+	var tags []SegmentTag
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitSegmentTags, &tags, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetSegmentTags(ctx, reuseTokenSource, companyID, segmentID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.SegmentTags, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
 func (c *Client) CreateSegmentTag(ctx context.Context, reuseTokenSource oauth2.TokenSource, segmentID int32, params SegmentTagParams) (*SegmentTag, error) {
 	var result SegmentTagResponse
 	err := c.call(ctx, path.Join(APIPathSegments, fmt.Sprint(segmentID), "tags"), http.MethodPost, reuseTokenSource, nil, params, &result)
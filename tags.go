@@ -72,6 +72,24 @@ func (c *Client) GetTags(ctx context.Context, reuseTokenSource oauth2.TokenSourc
 	return &result, nil
 }
 
+func (c *Client) GetAllTags(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetTagsOpts) ([]Tag, error) {
+	var tags []Tag
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitTags, &tags, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetTags(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Tags, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
 func (c *Client) CreateTag(ctx context.Context, reuseTokenSource oauth2.TokenSource, params TagParams) (*Tag, error) {
 	var result TagResponse
 	err := c.call(ctx, APIPathTags, http.MethodPost, reuseTokenSource, nil, params, &result)
@@ -19,18 +19,15 @@ type Transfers struct {
 	Transfers []Transfer `json:"transfers"`
 }
 
+type TransferResponse struct {
+	Transfer Transfer `json:"transfer"`
+}
+
 type GetTransfersOpts struct {
-	// walletable_type、walletable_idは同時に指定が必要です。
-	// 口座区分 (銀行口座: bank_account, クレジットカード: credit_card, 現金: wallet)
-	WalletableType string `url:"walletable_type,omitempty"`
-	// 口座ID
-	WalletableID int32 `url:"walletable_id,omitempty"`
-	// 取引日で絞込：開始日 (yyyy-mm-dd)
+	// 振替日で絞込：開始日 (yyyy-mm-dd)
 	StartDate string `url:"start_date,omitempty"`
-	// 取引日で絞込：終了日 (yyyy-mm-dd)
+	// 振替日で絞込：終了日 (yyyy-mm-dd)
 	EndDate string `url:"end_date,omitempty"`
-	// 入金／出金 (入金: income, 出金: expense)
-	EntrySide string `url:"entry_side,omitempty"`
 	// 取得レコードのオフセット (デフォルト: 0)
 	Offset int32 `url:"offset,omitempty"`
 	// 取得レコードの件数 (デフォルト: 20, 最小: 1, 最大: 100)
@@ -38,39 +35,48 @@ type GetTransfersOpts struct {
 }
 
 type Transfer struct {
-	// 明細ID
+	// 取引(振替)ID
 	ID int32 `json:"id"`
 	// 事業所ID
 	CompanyID int32 `json:"company_id"`
-	// 取引日（yyyy-mm-dd）
+	// 振替日 (yyyy-mm-dd)
 	Date string `json:"date"`
-	// 取引金額
-	Amount int32 `json:"amount"`
-	// 未決済金額
-	DueAmount int32 `json:"due_amount"`
-	// 残高
-	Balance int32 `json:"balance"`
-	// 入金/出勤（入金: income, 出勤: expense）
-	EntrySide string `json:"entry_side"`
-	// 口座区分 (銀行口座: bank_account, クレジットカード: credit_card, 現金: wallet)
-	WalletableType string `json:"walletable_type"`
-	// 口座ID
-	WalletableID int32 `json:"walletable_id"`
-	// 取引内容
-	Description string `json:"description"`
-	// 明細のステータス（消込待ち: 1, 消込済み: 2, 無視: 3, 消込中: 4）
-	Status uint `json:"status"`
-	// 登録時に自動登録ルールの設定が適用され、登録処理が実行された場合、 trueになります。〜を推測する、〜の消込をするの条件の場合は一致してもfalseになります。
-	RuleMatched bool `json:"rule_matched"`
+	// 振替金額
+	Amount int64 `json:"amount"`
+	// 振替元口座ID
+	FromWalletableID int32 `json:"from_walletable_id"`
+	// 振替元口座区分 (銀行口座: bank_account, クレジットカード: credit_card, 現金: wallet)
+	FromWalletableType string `json:"from_walletable_type"`
+	// 振替先口座ID
+	ToWalletableID int32 `json:"to_walletable_id"`
+	// 振替先口座区分 (銀行口座: bank_account, クレジットカード: credit_card, 現金: wallet)
+	ToWalletableType string `json:"to_walletable_type"`
+	// 備考
+	Description *string `json:"description,omitempty"`
+}
+
+type TransferParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// 振替日 (yyyy-mm-dd)
+	Date string `json:"date"`
+	// 振替金額
+	Amount int64 `json:"amount"`
+	// 振替元口座ID
+	FromWalletableID int32 `json:"from_walletable_id"`
+	// 振替元口座区分 (銀行口座: bank_account, クレジットカード: credit_card, 現金: wallet)
+	FromWalletableType string `json:"from_walletable_type"`
+	// 振替先口座ID
+	ToWalletableID int32 `json:"to_walletable_id"`
+	// 振替先口座区分 (銀行口座: bank_account, クレジットカード: credit_card, 現金: wallet)
+	ToWalletableType string `json:"to_walletable_type"`
+	// 備考
+	Description *string `json:"description,omitempty"`
 }
 
 func (c *Client) GetTransfers(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetTransfersOpts) (*Transfers, error) {
 	var result Transfers
 
-	if (opts.WalletableType != "" && opts.WalletableID == 0) || (opts.WalletableID != 0 && opts.WalletableType == "") {
-		return nil, fmt.Errorf("either walletable_type or walletable_id is specified, then other value must be set")
-	}
-
 	v, err := query.Values(opts)
 	if err != nil {
 		return nil, err
@@ -85,6 +91,71 @@ func (c *Client) GetTransfers(ctx context.Context, reuseTokenSource oauth2.Token
 	return &result, nil
 }
 
+func (c *Client) GetAllTransfers(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetTransfersOpts) ([]Transfer, error) {
+	var transfers []Transfer
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitTransfers, &transfers, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetTransfers(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.Transfers, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfers, nil
+}
+
+func (c *Client) GetTransfer(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, transferID int32) (*Transfer, error) {
+	var result TransferResponse
+
+	v, err := query.Values(nil)
+	if err != nil {
+		return nil, err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathTransfers, fmt.Sprint(transferID)), http.MethodGet, reuseTokenSource, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Transfer, nil
+}
+
+func (c *Client) CreateTransfer(ctx context.Context, reuseTokenSource oauth2.TokenSource, params TransferParams) (*Transfer, error) {
+	var result TransferResponse
+	err := c.call(ctx, APIPathTransfers, http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Transfer, nil
+}
+
+func (c *Client) UpdateTransfer(ctx context.Context, reuseTokenSource oauth2.TokenSource, transferID int32, params TransferParams) (*Transfer, error) {
+	var result TransferResponse
+	err := c.call(ctx, path.Join(APIPathTransfers, fmt.Sprint(transferID)), http.MethodPut, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Transfer, nil
+}
+
+func (c *Client) DestroyTransfer(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, transferID int32) error {
+	v, err := query.Values(nil)
+	if err != nil {
+		return err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathTransfers, fmt.Sprint(transferID)), http.MethodDelete, reuseTokenSource, v, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (s *Client) GetTransferOrderList() []string {
 	str := new(Transfer)
 
@@ -0,0 +1,80 @@
+package freee
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestTransferEndpoints(t *testing.T) {
+	t.Parallel()
+	transfer := `{"transfer":{"id":10,"company_id":1,"date":"2021-06-01","amount":5000,
+		"from_walletable_id":2,"from_walletable_type":"bank_account","to_walletable_id":3,"to_walletable_type":"wallet"}}`
+	params := TransferParams{
+		CompanyID:          1,
+		Date:               "2021-06-01",
+		Amount:             5000,
+		FromWalletableID:   2,
+		FromWalletableType: WalletTypeBankAccount,
+		ToWalletableID:     3,
+		ToWalletableType:   WalletTypeWallet,
+		Description:        stringPtr("引き出し"),
+	}
+	body := `{"company_id":1,"date":"2021-06-01","amount":5000,"from_walletable_id":2,"from_walletable_type":"bank_account",
+		"to_walletable_id":3,"to_walletable_type":"wallet","description":"引き出し"}`
+	check := func(got *Transfer) error {
+		if got.ID != 10 || got.Amount != 5000 || got.ToWalletableType != WalletTypeWallet {
+			return fmt.Errorf("unexpected transfer: %+v", got)
+		}
+		return nil
+	}
+
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "get",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.GetTransfer(ctx, ts, 1, 10)
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodGet, path: "/api/1/transfers/10", query: "company_id=1",
+			response: transfer,
+		},
+		{
+			name: "create",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.CreateTransfer(ctx, ts, params)
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodPost, path: "/api/1/transfers",
+			body: body, response: transfer,
+		},
+		{
+			name: "update",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.UpdateTransfer(ctx, ts, 10, params)
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodPut, path: "/api/1/transfers/10",
+			body: body, response: transfer,
+		},
+		{
+			name: "destroy",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				return c.DestroyTransfer(ctx, ts, 1, 10)
+			},
+			method: http.MethodDelete, path: "/api/1/transfers/10", query: "company_id=1",
+		},
+	})
+}
@@ -120,46 +120,46 @@ type Balance struct {
 	// 上位勘定科目カテゴリー名(勘定科目カテゴリーの時のみ、上層が存在する場合含まれる)
 	ParentAccountCategoryName *string `json:"parent_account_category_name,omitempty"`
 	// 期首残高
-	OpeningBalance *int32 `json:"opening_balance,omitempty"`
+	OpeningBalance *int64 `json:"opening_balance,omitempty"`
 	// 借方金額
-	DebitAmount *int32 `json:"debit_amount,omitempty"`
+	DebitAmount *int64 `json:"debit_amount,omitempty"`
 	// 貸方金額
-	CReditAmount *int32 `json:"credit_amount,omitempty"`
+	CReditAmount *int64 `json:"credit_amount,omitempty"`
 	// 期末残高
-	ClosingBalance *int32 `json:"closing_balance,omitempty"`
+	ClosingBalance *int64 `json:"closing_balance,omitempty"`
 	// 構成比
 	CompositionRatio *float64 `json:"composition_ratio,omitempty"`
 
 	// 前年度期末残高
-	LastYearClosingBalance *int32 `json:"last_year_closing_balance,omitempty"`
+	LastYearClosingBalance *int64 `json:"last_year_closing_balance,omitempty"`
 	// 前年比
 	YearOnYear *float64 `json:"year_on_year,omitempty"`
 
 	// 前々年度期末残高
-	TwoYearsBeforeClosingBalance *int32 `json:"two_years_before_closing_balance,omitempty"`
+	TwoYearsBeforeClosingBalance *int64 `json:"two_years_before_closing_balance,omitempty"`
 }
 
 type BalanceBreakdown struct {
 	ID   int32   `json:"id"`
 	Name *string `json:"name,omitempty"`
 	// 期首残高
-	OpeningBalance *int32 `json:"opening_balance,omitempty"`
+	OpeningBalance *int64 `json:"opening_balance,omitempty"`
 	// 借方金額
-	DebitAmount *int32 `json:"debit_amount,omitempty"`
+	DebitAmount *int64 `json:"debit_amount,omitempty"`
 	// 貸方金額
-	CReditAmount *int32 `json:"credit_amount,omitempty"`
+	CReditAmount *int64 `json:"credit_amount,omitempty"`
 	// 期末残高
-	ClosingBalance *int32 `json:"closing_balance,omitempty"`
+	ClosingBalance *int64 `json:"closing_balance,omitempty"`
 	// 構成比
 	CompositionRatio *float64 `json:"composition_ratio,omitempty"`
 
 	// 前年度期末残高
-	LastYearClosingBalance *int32 `json:"last_year_closing_balance,omitempty"`
+	LastYearClosingBalance *int64 `json:"last_year_closing_balance,omitempty"`
 	// 前年比
 	YearOnYear *float64 `json:"year_on_year,omitempty"`
 
 	// 前々年度期末残高
-	TwoYearsBeforeClosingBalance *int32 `json:"two_years_before_closing_balance,omitempty"`
+	TwoYearsBeforeClosingBalance *int64 `json:"two_years_before_closing_balance,omitempty"`
 }
 
 type TrialBSTwoYearsResponse struct {
@@ -194,10 +194,10 @@ type TrialCRThreeYearsResponse struct {
 	TrialCRThreeYears Report `json:"trial_cr_three_years"`
 }
 
-func (c *Client) GetTrialBS(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32) (*TrialBSResponse, error) {
+func (c *Client) GetTrialBS(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*TrialBSResponse, error) {
 	var result TrialBSResponse
 
-	v, err := query.Values(nil)
+	v, err := query.Values(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -209,10 +209,10 @@ func (c *Client) GetTrialBS(ctx context.Context, reuseTokenSource oauth2.TokenSo
 	return &result, nil
 }
 
-func (c *Client) GetTrialBSTwoYears(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32) (*TrialBSTwoYearsResponse, error) {
+func (c *Client) GetTrialBSTwoYears(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*TrialBSTwoYearsResponse, error) {
 	var result TrialBSTwoYearsResponse
 
-	v, err := query.Values(nil)
+	v, err := query.Values(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -224,10 +224,10 @@ func (c *Client) GetTrialBSTwoYears(ctx context.Context, reuseTokenSource oauth2
 	return &result, nil
 }
 
-func (c *Client) GetTrialBSThreeYears(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32) (*TrialBSThreeYearsResponse, error) {
+func (c *Client) GetTrialBSThreeYears(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*TrialBSThreeYearsResponse, error) {
 	var result TrialBSThreeYearsResponse
 
-	v, err := query.Values(nil)
+	v, err := query.Values(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -239,10 +239,10 @@ func (c *Client) GetTrialBSThreeYears(ctx context.Context, reuseTokenSource oaut
 	return &result, nil
 }
 
-func (c *Client) GetTrialPL(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32) (*TrialPLResponse, error) {
+func (c *Client) GetTrialPL(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*TrialPLResponse, error) {
 	var result TrialPLResponse
 
-	v, err := query.Values(nil)
+	v, err := query.Values(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -254,10 +254,10 @@ func (c *Client) GetTrialPL(ctx context.Context, reuseTokenSource oauth2.TokenSo
 	return &result, nil
 }
 
-func (c *Client) GetTrialPLTwoYears(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32) (*TrialPLTwoYearsResponse, error) {
+func (c *Client) GetTrialPLTwoYears(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*TrialPLTwoYearsResponse, error) {
 	var result TrialPLTwoYearsResponse
 
-	v, err := query.Values(nil)
+	v, err := query.Values(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -269,10 +269,10 @@ func (c *Client) GetTrialPLTwoYears(ctx context.Context, reuseTokenSource oauth2
 	return &result, nil
 }
 
-func (c *Client) GetTrialPLThreeYears(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32) (*TrialPLThreeYearsResponse, error) {
+func (c *Client) GetTrialPLThreeYears(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*TrialPLThreeYearsResponse, error) {
 	var result TrialPLThreeYearsResponse
 
-	v, err := query.Values(nil)
+	v, err := query.Values(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -284,10 +284,10 @@ func (c *Client) GetTrialPLThreeYears(ctx context.Context, reuseTokenSource oaut
 	return &result, nil
 }
 
-func (c *Client) GetTrialCR(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32) (*TrialCRResponse, error) {
+func (c *Client) GetTrialCR(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*TrialCRResponse, error) {
 	var result TrialCRResponse
 
-	v, err := query.Values(nil)
+	v, err := query.Values(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -299,10 +299,10 @@ func (c *Client) GetTrialCR(ctx context.Context, reuseTokenSource oauth2.TokenSo
 	return &result, nil
 }
 
-func (c *Client) GetTrialCRTwoYears(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32) (*TrialCRTwoYearsResponse, error) {
+func (c *Client) GetTrialCRTwoYears(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*TrialCRTwoYearsResponse, error) {
 	var result TrialCRTwoYearsResponse
 
-	v, err := query.Values(nil)
+	v, err := query.Values(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -314,10 +314,10 @@ func (c *Client) GetTrialCRTwoYears(ctx context.Context, reuseTokenSource oauth2
 	return &result, nil
 }
 
-func (c *Client) GetTrialCRThreeYears(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32) (*TrialCRThreeYearsResponse, error) {
+func (c *Client) GetTrialCRThreeYears(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*TrialCRThreeYearsResponse, error) {
 	var result TrialCRThreeYearsResponse
 
-	v, err := query.Values(nil)
+	v, err := query.Values(opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,195 @@
+package freee
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	// 試算表の出力で階層を表すインデント
+	reportIndent = "　"
+	// XLSX のシート名
+	reportSheetName = "試算表"
+)
+
+// reportAmounts is a set of amounts shared by Balance and BalanceBreakdown.
+type reportAmounts struct {
+	OpeningBalance               *int64
+	DebitAmount                  *int64
+	CreditAmount                 *int64
+	ClosingBalance               *int64
+	CompositionRatio             *float64
+	LastYearClosingBalance       *int64
+	YearOnYear                   *float64
+	TwoYearsBeforeClosingBalance *int64
+}
+
+type reportColumn struct {
+	title string
+	ratio bool
+	value func(a reportAmounts) *float64
+}
+
+var reportColumns = []reportColumn{
+	{"前々年度期末残高", false, func(a reportAmounts) *float64 { return int64ToFloat(a.TwoYearsBeforeClosingBalance) }},
+	{"前年度期末残高", false, func(a reportAmounts) *float64 { return int64ToFloat(a.LastYearClosingBalance) }},
+	{"期首残高", false, func(a reportAmounts) *float64 { return int64ToFloat(a.OpeningBalance) }},
+	{"借方金額", false, func(a reportAmounts) *float64 { return int64ToFloat(a.DebitAmount) }},
+	{"貸方金額", false, func(a reportAmounts) *float64 { return int64ToFloat(a.CreditAmount) }},
+	{"期末残高", false, func(a reportAmounts) *float64 { return int64ToFloat(a.ClosingBalance) }},
+	{"構成比", true, func(a reportAmounts) *float64 { return a.CompositionRatio }},
+	{"前年比", true, func(a reportAmounts) *float64 { return a.YearOnYear }},
+}
+
+type reportRow struct {
+	level   int
+	label   string
+	total   bool
+	amounts reportAmounts
+}
+
+func (b *Balance) amounts() reportAmounts {
+	return reportAmounts{
+		OpeningBalance:               b.OpeningBalance,
+		DebitAmount:                  b.DebitAmount,
+		CreditAmount:                 b.CReditAmount,
+		ClosingBalance:               b.ClosingBalance,
+		CompositionRatio:             b.CompositionRatio,
+		LastYearClosingBalance:       b.LastYearClosingBalance,
+		YearOnYear:                   b.YearOnYear,
+		TwoYearsBeforeClosingBalance: b.TwoYearsBeforeClosingBalance,
+	}
+}
+
+func (b *BalanceBreakdown) amounts() reportAmounts {
+	return reportAmounts{
+		OpeningBalance:               b.OpeningBalance,
+		DebitAmount:                  b.DebitAmount,
+		CreditAmount:                 b.CReditAmount,
+		ClosingBalance:               b.ClosingBalance,
+		CompositionRatio:             b.CompositionRatio,
+		LastYearClosingBalance:       b.LastYearClosingBalance,
+		YearOnYear:                   b.YearOnYear,
+		TwoYearsBeforeClosingBalance: b.TwoYearsBeforeClosingBalance,
+	}
+}
+
+// Label returns the name of the balance row: account item, account group or account category.
+func (b *Balance) Label() string {
+	for _, name := range []*string{b.AccountItemName, b.AccountGroupName, b.AccountCategoryName} {
+		if name != nil {
+			return *name
+		}
+	}
+	return ""
+}
+
+// rows flattens the balances with their breakdowns, and returns them with
+// the columns which have at least one value.
+func (r *Report) rows() ([]reportRow, []reportColumn) {
+	var rows []reportRow
+	for i := range r.Balances {
+		b := &r.Balances[i]
+		level := 1
+		if b.HierarchyLevel != nil {
+			level = int(*b.HierarchyLevel)
+		}
+		rows = append(rows, reportRow{
+			level:   level,
+			label:   b.Label(),
+			total:   b.TotalLine != nil && *b.TotalLine,
+			amounts: b.amounts(),
+		})
+		for _, bd := range b.Breakdowns() {
+			bd := bd
+			label := ""
+			if bd.Name != nil {
+				label = *bd.Name
+			}
+			rows = append(rows, reportRow{
+				level:   level + 1,
+				label:   label,
+				amounts: bd.amounts(),
+			})
+		}
+	}
+
+	var columns []reportColumn
+	for _, col := range reportColumns {
+		for _, row := range rows {
+			if col.value(row.amounts) != nil {
+				columns = append(columns, col)
+				break
+			}
+		}
+	}
+	return rows, columns
+}
+
+func (row reportRow) indentedLabel() string {
+	if row.level <= 1 {
+		return row.label
+	}
+	return strings.Repeat(reportIndent, row.level-1) + row.label
+}
+
+// WriteCSV writes the balances of the report as CSV. The account hierarchy is
+// expressed by indenting the names, and breakdowns follow their account item.
+func (r *Report) WriteCSV(w io.Writer) error {
+	rows, columns := r.rows()
+	cw := csv.NewWriter(w)
+
+	header := []string{"勘定科目"}
+	for _, col := range columns {
+		header = append(header, col.title)
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		record := []string{row.indentedLabel()}
+		for _, col := range columns {
+			v := col.value(row.amounts)
+			if v == nil {
+				record = append(record, "")
+				continue
+			}
+			record = append(record, strconv.FormatFloat(*v, 'f', -1, 64))
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteXLSX writes the balances of the report as a XLSX workbook in the same
+// layout as WriteCSV. Total lines are written in bold.
+func (r *Report) WriteXLSX(w io.Writer) error {
+	rows, columns := r.rows()
+
+	header := []xlsxCell{{text: "勘定科目", bold: true}}
+	for _, col := range columns {
+		header = append(header, xlsxCell{text: col.title, bold: true})
+	}
+	cells := [][]xlsxCell{header}
+	for _, row := range rows {
+		record := []xlsxCell{{text: row.indentedLabel(), bold: row.total}}
+		for _, col := range columns {
+			record = append(record, xlsxCell{number: col.value(row.amounts), ratio: col.ratio, bold: row.total})
+		}
+		cells = append(cells, record)
+	}
+	return writeXLSX(w, reportSheetName, cells)
+}
+
+func int64ToFloat(v *int64) *float64 {
+	if v == nil {
+		return nil
+	}
+	f := float64(*v)
+	return &f
+}
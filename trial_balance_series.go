@@ -0,0 +1,340 @@
+package freee
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	ReportTypeTrialBS = "trial_bs"
+	ReportTypeTrialPL = "trial_pl"
+	ReportTypeTrialCR = "trial_cr"
+
+	// 月次推移の取得の同時実行数のデフォルト
+	DefaultTrialBalanceSeriesConcurrency = 4
+
+	dateLayout = "2006-01-02"
+)
+
+// TrialBalanceSeries is a monthly trial balance of a fiscal year.
+type TrialBalanceSeries struct {
+	// 事業所ID
+	CompanyID int32
+	// 会計年度
+	FiscalYear int32
+	// 試算表の種類 (trial_bs, trial_pl, trial_cr)
+	ReportType string
+	// 列（会計月）
+	Months []TrialBalanceMonth
+	// 行（勘定科目・内訳）
+	Rows []*TrialBalanceSeriesRow
+}
+
+type TrialBalanceMonth struct {
+	// 会計月(1-12)
+	Month int32
+	// 開始日 (yyyy-mm-dd)
+	StartDate string
+	// 終了日 (yyyy-mm-dd)
+	EndDate string
+}
+
+type TrialBalanceSeriesRow struct {
+	// 勘定科目ID(勘定科目の時のみ含まれる)
+	AccountItemID *int32
+	// 勘定科目名(勘定科目の時のみ含まれる)
+	AccountItemName *string
+	// 決算書表示名(account_item_display_type:group指定時のみ含まれる)
+	AccountGroupName *string
+	// 勘定科目カテゴリー名
+	AccountCategoryName *string
+	// 上位勘定科目カテゴリー名
+	ParentAccountCategoryName *string
+	// 階層レベル
+	HierarchyLevel *int32
+	// 合計行
+	TotalLine bool
+	// 内訳ID(breakdown_display_type指定時の内訳行のみ含まれる)
+	BreakdownID *int32
+	// 内訳名(breakdown_display_type指定時の内訳行のみ含まれる)
+	BreakdownName *string
+	// 会計月ごとの金額（Months と同じ順序）。B/S は月末残高、P/L, C/R は当月発生額です。
+	Values []int64
+	// 合計。B/S は最終月の残高、P/L, C/R は各月の合計です。
+	Total int64
+}
+
+// GetTrialBalanceSeries fetches the trial balance of each month in the fiscal
+// year concurrently and arranges them by account item and breakdown.
+// opts is used as filters of each request, except for the period.
+// Requests are sent through the Client, so they respect Config.Limiter.
+func (c *Client) GetTrialBalanceSeries(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, reportType string, fiscalYear int32, opts GetReportsOpts, concurrency int) (*TrialBalanceSeries, error) {
+	company, err := c.GetCompany(ctx, reuseTokenSource, companyID, nil)
+	if err != nil {
+		return nil, err
+	}
+	months, err := fiscalMonths(company.Company.FiscalYears, fiscalYear)
+	if err != nil {
+		return nil, err
+	}
+
+	reports, err := c.getMonthlyReports(ctx, reuseTokenSource, companyID, reportType, fiscalYear, months, opts, concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	series := &TrialBalanceSeries{
+		CompanyID:  companyID,
+		FiscalYear: fiscalYear,
+		ReportType: reportType,
+		Months:     months,
+	}
+	series.build(reports)
+	return series, nil
+}
+
+func (c *Client) getMonthlyReports(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, reportType string, fiscalYear int32, months []TrialBalanceMonth, opts GetReportsOpts, concurrency int) ([]*Report, error) {
+	if concurrency <= 0 {
+		concurrency = DefaultTrialBalanceSeriesConcurrency
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		reports  = make([]*Report, len(months))
+		sem      = make(chan struct{}, concurrency)
+	)
+	for i, month := range months {
+		i, month := i, month
+		o := opts
+		o.FiscalYear = fiscalYear
+		o.StartMonth = month.Month
+		o.EndMonth = month.Month
+		o.StartDate = ""
+		o.EndDate = ""
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+			report, err := c.getReport(ctx, reuseTokenSource, companyID, reportType, o)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+			reports[i] = report
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
+
+func (c *Client) getReport(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, reportType string, opts GetReportsOpts) (*Report, error) {
+	switch reportType {
+	case ReportTypeTrialBS:
+		result, err := c.GetTrialBS(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &result.TrialBS, nil
+	case ReportTypeTrialPL:
+		result, err := c.GetTrialPL(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &result.TrialPL, nil
+	case ReportTypeTrialCR:
+		result, err := c.GetTrialCR(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, err
+		}
+		return &result.TrialCR, nil
+	}
+	return nil, fmt.Errorf("unsupported report type: %s", reportType)
+}
+
+// fiscalMonths returns the months of the fiscal year which starts in fiscalYear.
+func fiscalMonths(fiscalYears *[]FiscalYears, fiscalYear int32) ([]TrialBalanceMonth, error) {
+	if fiscalYears == nil {
+		return nil, fmt.Errorf("fiscal years are not found")
+	}
+	for _, fy := range *fiscalYears {
+		if fy.StartDate == nil || fy.EndDate == nil {
+			continue
+		}
+		start, err := time.Parse(dateLayout, *fy.StartDate)
+		if err != nil {
+			return nil, err
+		}
+		if int32(start.Year()) != fiscalYear {
+			continue
+		}
+		end, err := time.Parse(dateLayout, *fy.EndDate)
+		if err != nil {
+			return nil, err
+		}
+
+		var months []TrialBalanceMonth
+		for from := start; !from.After(end); {
+			to := time.Date(from.Year(), from.Month()+1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+			if to.After(end) {
+				to = end
+			}
+			months = append(months, TrialBalanceMonth{
+				Month:     int32(from.Month()),
+				StartDate: from.Format(dateLayout),
+				EndDate:   to.Format(dateLayout),
+			})
+			from = to.AddDate(0, 0, 1)
+		}
+		return months, nil
+	}
+	return nil, fmt.Errorf("fiscal year %d is not found", fiscalYear)
+}
+
+// build arranges the balances of the monthly reports to the rows.
+// A row which first appears in a later month is inserted after the row
+// preceding it in that month, so the rows keep the order of the reports.
+func (s *TrialBalanceSeries) build(reports []*Report) {
+	rows := map[string]*TrialBalanceSeriesRow{}
+	var prev *TrialBalanceSeriesRow
+	row := func(key string, b *Balance) *TrialBalanceSeriesRow {
+		r, ok := rows[key]
+		if !ok {
+			r = &TrialBalanceSeriesRow{
+				AccountItemID:             b.AccountItemID,
+				AccountItemName:           b.AccountItemName,
+				AccountGroupName:          b.AccountGroupName,
+				AccountCategoryName:       b.AccountCategoryName,
+				ParentAccountCategoryName: b.ParentAccountCategoryName,
+				HierarchyLevel:            b.HierarchyLevel,
+				TotalLine:                 b.TotalLine != nil && *b.TotalLine,
+				Values:                    make([]int64, len(s.Months)),
+			}
+			rows[key] = r
+			s.insertRow(r, prev)
+		}
+		prev = r
+		return r
+	}
+
+	for i, report := range reports {
+		prev = nil
+		unnamed := map[int32]int{}
+		for j := range report.Balances {
+			b := &report.Balances[j]
+			key := balanceKey(b, unnamed)
+			row(key, b).Values[i] = s.value(b.OpeningBalance, b.ClosingBalance)
+			for _, bd := range b.Breakdowns() {
+				bd := bd
+				r := row(fmt.Sprintf("%s/%d", key, bd.ID), b)
+				r.BreakdownID = &bd.ID
+				r.BreakdownName = bd.Name
+				r.Values[i] = s.value(bd.OpeningBalance, bd.ClosingBalance)
+			}
+		}
+	}
+
+	for _, r := range s.Rows {
+		r.Total = 0
+		if s.ReportType == ReportTypeTrialBS {
+			if len(r.Values) > 0 {
+				r.Total = r.Values[len(r.Values)-1]
+			}
+			continue
+		}
+		for _, v := range r.Values {
+			r.Total += v
+		}
+	}
+}
+
+// insertRow inserts r after prev, or at the top if prev is nil.
+func (s *TrialBalanceSeries) insertRow(r, prev *TrialBalanceSeriesRow) {
+	at := 0
+	if prev != nil {
+		for i, row := range s.Rows {
+			if row == prev {
+				at = i + 1
+				break
+			}
+		}
+	}
+	s.Rows = append(s.Rows, nil)
+	copy(s.Rows[at+1:], s.Rows[at:])
+	s.Rows[at] = r
+}
+
+// value returns the closing balance for B/S, and the amount of the month for P/L and C/R.
+func (s *TrialBalanceSeries) value(opening, closing *int64) int64 {
+	var v int64
+	if closing != nil {
+		v = *closing
+	}
+	if s.ReportType != ReportTypeTrialBS && opening != nil {
+		v -= *opening
+	}
+	return v
+}
+
+// balanceKey identifies the row of the balance across the monthly reports.
+// Rows without account item, group and category are identified by their
+// hierarchy level and their order among such rows of the level, counted in
+// unnamed, since the reports share the same layout of them.
+func balanceKey(b *Balance, unnamed map[int32]int) string {
+	var parts []string
+	if b.AccountItemID != nil {
+		parts = append(parts, fmt.Sprintf("account_item:%d", *b.AccountItemID))
+	}
+	if b.AccountGroupName != nil {
+		parts = append(parts, "group:"+*b.AccountGroupName)
+	}
+	if b.AccountCategoryName != nil {
+		parts = append(parts, "category:"+*b.AccountCategoryName)
+	}
+	if len(parts) == 0 {
+		var level int32
+		if b.HierarchyLevel != nil {
+			level = *b.HierarchyLevel
+		}
+		unnamed[level]++
+		return fmt.Sprintf("row:%d/%d", level, unnamed[level])
+	}
+	return strings.Join(parts, "/")
+}
+
+// Breakdowns returns the breakdown rows of the balance, whichever
+// breakdown_display_type is specified.
+func (b *Balance) Breakdowns() []BalanceBreakdown {
+	for _, breakdowns := range []*[]BalanceBreakdown{b.Partners, b.Items, b.Sections, b.Segment1Tags, b.Segment2Tags, b.Segment3Tags} {
+		if breakdowns != nil {
+			return *breakdowns
+		}
+	}
+	return nil
+}
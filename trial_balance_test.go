@@ -0,0 +1,217 @@
+package freee
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+func TestFiscalMonths(t *testing.T) {
+	t.Parallel()
+	start, end := "2021-04-01", "2022-03-31"
+	short, shortEnd := "2020-10-15", "2021-03-31"
+	fiscalYears := &[]FiscalYears{
+		{StartDate: &short, EndDate: &shortEnd},
+		{StartDate: &start, EndDate: &end},
+	}
+
+	months, err := fiscalMonths(fiscalYears, 2021)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(months) != 12 {
+		t.Fatalf("unmatch month nums : %d", len(months))
+	}
+	if months[0] != (TrialBalanceMonth{4, "2021-04-01", "2021-04-30"}) || months[11] != (TrialBalanceMonth{3, "2022-03-01", "2022-03-31"}) {
+		t.Fatalf("unexpected months: %v", months)
+	}
+
+	months, err = fiscalMonths(fiscalYears, 2020)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(months) != 6 || months[0].StartDate != "2020-10-15" {
+		t.Fatalf("unexpected months: %v", months)
+	}
+
+	if _, err := fiscalMonths(fiscalYears, 2019); err == nil {
+		t.Fatal("fiscal year 2019 is found")
+	}
+}
+
+func TestGetTrialBalanceSeries(t *testing.T) {
+	t.Parallel()
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/companies/1":
+			fmt.Fprint(w, `{"company":{"id":1,"fiscal_years":[{"start_date":"2021-01-01","end_date":"2021-12-31"}]}}`)
+		case "/api/1/reports/trial_pl":
+			q := r.URL.Query()
+			if q.Get("fiscal_year") != "2021" || q.Get("start_month") != q.Get("end_month") || q.Get("breakdown_display_type") != "section" {
+				t.Errorf("unexpected query: %s", r.URL.RawQuery)
+			}
+			month, _ := strconv.Atoi(q.Get("start_month"))
+			// 売上高は累計、部門は当月のみ（期首残高 0）
+			fmt.Fprintf(w, `{"trial_pl":{"company_id":1,"balances":[
+				{"account_item_id":10,"account_item_name":"売上高","opening_balance":%d,"closing_balance":%d,
+				 "sections":[{"id":5,"name":"営業部","opening_balance":0,"closing_balance":100}]},
+				{"account_category_name":"売上高","total_line":true,"hierarchy_level":1,"opening_balance":0,"closing_balance":100},
+				{"hierarchy_level":1,"opening_balance":0,"closing_balance":100},
+				{"hierarchy_level":1,"opening_balance":0,"closing_balance":100}
+			]}}`, (month-1)*100, month*100)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	series, err := client.GetTrialBalanceSeries(context.Background(), ts, 1, ReportTypeTrialPL, 2021, GetReportsOpts{BreakdownDisplayType: "section"}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 勘定科目・決算書表示名・カテゴリーのない行も別の行として集計する
+	if len(series.Months) != 12 || len(series.Rows) != 5 {
+		t.Fatalf("unexpected series: %d months, %d rows", len(series.Months), len(series.Rows))
+	}
+	for _, row := range series.Rows {
+		if row.Total != 1200 {
+			t.Fatalf("unexpected total: %d", row.Total)
+		}
+		for _, v := range row.Values {
+			if v != 100 {
+				t.Fatalf("unexpected values: %v", row.Values)
+			}
+		}
+	}
+	if series.Rows[1].BreakdownID == nil || *series.Rows[1].BreakdownID != 5 {
+		t.Fatal("breakdown row is not found")
+	}
+}
+
+func TestTrialBalanceSeriesBuildOrder(t *testing.T) {
+	t.Parallel()
+	monthly := []string{
+		`{"balances":[
+			{"account_item_id":10,"account_item_name":"売上高","hierarchy_level":2,"opening_balance":0,"closing_balance":100},
+			{"account_category_name":"売上高","total_line":true,"hierarchy_level":1,"opening_balance":0,"closing_balance":100},
+			{"hierarchy_level":1,"opening_balance":0,"closing_balance":100}
+		]}`,
+		// 2か月目に雑収入が売上高の次に現れる
+		`{"balances":[
+			{"account_item_id":10,"account_item_name":"売上高","hierarchy_level":2,"opening_balance":0,"closing_balance":100},
+			{"account_item_id":11,"account_item_name":"雑収入","hierarchy_level":2,"opening_balance":0,"closing_balance":50,
+			 "sections":[{"id":5,"name":"営業部","opening_balance":0,"closing_balance":50}]},
+			{"account_category_name":"売上高","total_line":true,"hierarchy_level":1,"opening_balance":0,"closing_balance":150},
+			{"hierarchy_level":1,"opening_balance":0,"closing_balance":150}
+		]}`,
+	}
+	var reports []*Report
+	for _, m := range monthly {
+		var report Report
+		if err := json.Unmarshal([]byte(m), &report); err != nil {
+			t.Fatal(err)
+		}
+		reports = append(reports, &report)
+	}
+	series := &TrialBalanceSeries{ReportType: ReportTypeTrialPL, Months: make([]TrialBalanceMonth, len(reports))}
+	series.build(reports)
+
+	var got []string
+	for _, r := range series.Rows {
+		switch {
+		case r.BreakdownName != nil:
+			got = append(got, *r.BreakdownName)
+		case r.AccountItemName != nil:
+			got = append(got, *r.AccountItemName)
+		case r.AccountCategoryName != nil:
+			got = append(got, *r.AccountCategoryName+"計")
+		default:
+			got = append(got, "合計")
+		}
+	}
+	want := []string{"売上高", "雑収入", "営業部", "売上高計", "合計"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("unexpected rows: %v, %v", want, got)
+	}
+	if series.Rows[1].Values[0] != 0 || series.Rows[1].Total != 50 || series.Rows[4].Total != 250 {
+		t.Fatalf("unexpected values: %v, %v", series.Rows[1].Values, series.Rows[4].Values)
+	}
+}
+
+func testReport(t *testing.T) *Report {
+	t.Helper()
+	var result TrialPLTwoYearsResponse
+	err := json.Unmarshal([]byte(`{"trial_pl_two_years":{"company_id":1,"balances":[
+		{"account_item_id":10,"account_item_name":"売上高","hierarchy_level":2,"last_year_closing_balance":900,"closing_balance":1000,"year_on_year":1.11,
+		 "partners":[{"id":5,"name":"取引先A","last_year_closing_balance":900,"closing_balance":1000,"year_on_year":1.11}]},
+		{"account_category_name":"売上高","total_line":true,"hierarchy_level":1,"last_year_closing_balance":900,"closing_balance":1000,"year_on_year":1.11}
+	]}}`), &result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &result.TrialPLTwoYears
+}
+
+func TestReportWriteCSV(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	if err := testReport(t).WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := "勘定科目,前年度期末残高,期末残高,前年比\n" +
+		"\"　売上高\",900,1000,1.11\n" +
+		"\"　　取引先A\",900,1000,1.11\n" +
+		"売上高,900,1000,1.11\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected csv:\n%s", buf.String())
+	}
+}
+
+func TestReportWriteXLSX(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	if err := testReport(t).WriteXLSX(&buf); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range zr.File {
+		if f.Name != "xl/worksheets/sheet1.xml" {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rc.Close()
+		var sheet struct {
+			Rows []struct {
+				Cells []struct {
+					Ref   string `xml:"r,attr"`
+					Style int    `xml:"s,attr"`
+					Value string `xml:"v"`
+					Text  string `xml:"is>t"`
+				} `xml:"c"`
+			} `xml:"sheetData>row"`
+		}
+		if err := xml.NewDecoder(rc).Decode(&sheet); err != nil {
+			t.Fatal(err)
+		}
+		if len(sheet.Rows) != 4 {
+			t.Fatalf("unmatch row nums : %d", len(sheet.Rows))
+		}
+		total := sheet.Rows[3].Cells
+		if total[0].Text != "売上高" || total[0].Style != xlsxStyleBold || total[2].Ref != "C4" || total[2].Value != "1000" || total[2].Style != xlsxStyleBoldNumber {
+			t.Fatalf("unexpected total row: %+v", total)
+		}
+		return
+	}
+	t.Fatal("sheet is not found")
+}
@@ -8,3 +8,53 @@ import (
 func SetCompanyID(v *url.Values, companyID int32) {
 	v.Set("company_id", fmt.Sprintf("%d", companyID))
 }
+
+func nonZeroInt32(v int32) *int32 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func containsInt32(s []int32, v int32) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+func int32Value(v *int32) int32 {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+func stringValue(v *string) string {
+	if v == nil {
+		return ""
+	}
+	return *v
+}
+
+func cloneString(v *string) *string {
+	if v == nil {
+		return nil
+	}
+	s := *v
+	return &s
+}
+
+func cloneInt32(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := *v
+	return &i
+}
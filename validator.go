@@ -0,0 +1,262 @@
+package freee
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FieldError is a validation error of a field in the request params.
+type FieldError struct {
+	// フィールド名（例: details[1].tax_code）
+	Field string
+	// エラー内容
+	Message string
+	// エラーの種類 (ErrValidation, ErrMonthClosed)
+	Kind ErrorKind
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationErrors is a list of field errors found by the offline validations,
+// such as JournalValidator.
+// errors.Is reports true for ErrValidation, and for the kinds of the field errors.
+type ValidationErrors []*FieldError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Error())
+	}
+	return "freee: validation failed: " + strings.Join(msgs, ", ")
+}
+
+func (e ValidationErrors) Is(target error) bool {
+	kind, ok := target.(ErrorKind)
+	if !ok {
+		return false
+	}
+	if kind == ErrValidation {
+		return true
+	}
+	for _, fe := range e {
+		if fe.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *ValidationErrors) add(kind ErrorKind, field string, format string, args ...interface{}) {
+	*e = append(*e, &FieldError{Field: field, Message: fmt.Sprintf(format, args...), Kind: kind})
+}
+
+// JournalValidator validates manual journals and deals offline with the cached
+// settings of the company, before sending them to freee.
+// Settings which are nil or empty are not validated.
+type JournalValidator struct {
+	// 勘定科目（勘定科目IDをキーとする）
+	AccountItems map[int32]AccountItem
+	// 利用できる税区分コード
+	TaxCodes map[int32]bool
+	// 勘定科目ごとに利用できる品目ID（勘定科目IDをキーとする）。登録のない勘定科目の品目は制限しません
+	// NewJournalValidator は設定しません。品目の紐付けは勘定科目の詳細情報にのみ含まれるため、
+	// GetAccountItem の結果を SetAccountItemDetails で設定してください
+	AccountItemItems map[int32][]int32
+	// 月締めした最終日。この日以前の発生日は登録できません（ゼロ値の場合は検証しません）
+	ClosedUntil time.Time
+}
+
+// NewJournalValidator returns a JournalValidator with the account items and the
+// tax codes of the company, such as the results of GetAccountItems and GetTaxCompanies.
+// closedUntil is the last closed date (yyyy-mm-dd) of the company, or empty if
+// no month is closed. It returns an error if closedUntil is malformed.
+func NewJournalValidator(accountItems []AccountItem, taxes []TaxCompany, closedUntil string) (*JournalValidator, error) {
+	v := &JournalValidator{
+		AccountItems: make(map[int32]AccountItem, len(accountItems)),
+		TaxCodes:     make(map[int32]bool, len(taxes)),
+	}
+	if closedUntil != "" {
+		closed, err := time.Parse(dateLayout, closedUntil)
+		if err != nil {
+			return nil, fmt.Errorf("invalid closed date: %q", closedUntil)
+		}
+		v.ClosedUntil = closed
+	}
+	for _, a := range accountItems {
+		v.AccountItems[a.ID] = a
+	}
+	for _, t := range taxes {
+		if t.Available {
+			v.TaxCodes[t.Code] = true
+		}
+	}
+	return v, nil
+}
+
+// SetAccountItemDetails sets the items linked to the account items, such as
+// the results of GetAccountItem, to AccountItemItems.
+// Details without items are skipped, so their items are not restricted.
+func (v *JournalValidator) SetAccountItemDetails(details ...AccountItemDetail) {
+	if v.AccountItemItems == nil {
+		v.AccountItemItems = make(map[int32][]int32, len(details))
+	}
+	for _, d := range details {
+		if d.Items == nil {
+			continue
+		}
+		ids := make([]int32, 0, len(*d.Items))
+		for _, item := range *d.Items {
+			ids = append(ids, item.ID)
+		}
+		v.AccountItemItems[d.ID] = ids
+	}
+}
+
+// journalLine is a detail line shared by manual journals and deals.
+type journalLine struct {
+	field         string
+	accountItemID int32
+	taxCode       int32
+	itemID        int32
+	amount        int64
+}
+
+// ValidateManualJournal validates the params, and returns ValidationErrors if any.
+func (v *JournalValidator) ValidateManualJournal(params CreateManualJournalParams) error {
+	var errs ValidationErrors
+	v.validateDate(&errs, "issue_date", params.IssueDate)
+
+	details := params.CreateManualJournalParamsDetails
+	if len(details) == 0 {
+		errs.add(ErrValidation, "details", "must not be empty")
+	}
+	if len(details) > ManualJournalMaxDetails {
+		errs.add(ErrValidation, "details", "must have %d lines or less: %d", ManualJournalMaxDetails, len(details))
+	}
+
+	var debit, credit int64
+	for i, d := range details {
+		line := journalLine{
+			field:         fmt.Sprintf("details[%d]", i),
+			accountItemID: d.AccountItemID,
+			taxCode:       d.TaxCode,
+			itemID:        d.ItemID,
+			amount:        d.Amount,
+		}
+		v.validateLine(&errs, line)
+		switch d.EntrySide {
+		case ManualJournalEntrySideDebit:
+			debit += d.Amount
+		case ManualJournalEntrySideCredit:
+			credit += d.Amount
+		default:
+			errs.add(ErrValidation, line.field+".entry_side", "must be %s or %s: %q", ManualJournalEntrySideCredit, ManualJournalEntrySideDebit, d.EntrySide)
+		}
+	}
+	if debit != credit {
+		errs.add(ErrValidation, "details", "debit total %d does not match credit total %d", debit, credit)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ValidateDeal validates the params, and returns ValidationErrors if any.
+// The total of the payments must not exceed the total of the details.
+func (v *JournalValidator) ValidateDeal(params DealCreateParams) error {
+	var errs ValidationErrors
+	v.validateDate(&errs, "issue_date", params.IssueDate)
+	if params.Type != DealTypeIncome && params.Type != DealTypeExpense {
+		errs.add(ErrValidation, "type", "must be %s or %s: %q", DealTypeIncome, DealTypeExpense, params.Type)
+	}
+
+	if len(params.Details) == 0 {
+		errs.add(ErrValidation, "details", "must not be empty")
+	}
+	if len(params.Details) > DealMaxDetails {
+		errs.add(ErrValidation, "details", "must have %d lines or less: %d", DealMaxDetails, len(params.Details))
+	}
+
+	var total int64
+	for i, d := range params.Details {
+		v.validateLine(&errs, journalLine{
+			field:         fmt.Sprintf("details[%d]", i),
+			accountItemID: d.AccountItemID,
+			taxCode:       d.TaxCode,
+			itemID:        int32Value(d.ItemID),
+			amount:        d.Amount,
+		})
+		total += d.Amount
+	}
+
+	if params.Payments != nil {
+		var paid int64
+		for i, p := range *params.Payments {
+			field := fmt.Sprintf("payments[%d]", i)
+			if p.Amount <= 0 {
+				errs.add(ErrValidation, field+".amount", "must be positive: %d", p.Amount)
+			}
+			v.validateDate(&errs, field+".date", p.Date)
+			paid += p.Amount
+		}
+		if paid > total {
+			errs.add(ErrValidation, "payments", "payment total %d exceeds deal total %d", paid, total)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (v *JournalValidator) validateLine(errs *ValidationErrors, line journalLine) {
+	if line.amount <= 0 {
+		errs.add(ErrValidation, line.field+".amount", "must be positive: %d", line.amount)
+	}
+
+	if line.taxCode == 0 {
+		errs.add(ErrValidation, line.field+".tax_code", "is required")
+	} else if v.TaxCodes != nil && !v.TaxCodes[line.taxCode] {
+		errs.add(ErrValidation, line.field+".tax_code", "is not available: %d", line.taxCode)
+	}
+
+	if line.accountItemID == 0 {
+		errs.add(ErrValidation, line.field+".account_item_id", "is required")
+		return
+	}
+	if v.AccountItems != nil {
+		a, ok := v.AccountItems[line.accountItemID]
+		if !ok {
+			errs.add(ErrValidation, line.field+".account_item_id", "is not found: %d", line.accountItemID)
+			return
+		}
+		if !a.Available {
+			errs.add(ErrValidation, line.field+".account_item_id", "is not available: %s", a.Name)
+		}
+	}
+	if line.itemID != 0 {
+		if items, ok := v.AccountItemItems[line.accountItemID]; ok && !containsInt32(items, line.itemID) {
+			errs.add(ErrValidation, line.field+".item_id", "is not linked to account item %d: %d", line.accountItemID, line.itemID)
+		}
+	}
+}
+
+func (v *JournalValidator) validateDate(errs *ValidationErrors, field string, date string) {
+	d, err := time.Parse(dateLayout, date)
+	if err != nil {
+		errs.add(ErrValidation, field, "must be yyyy-mm-dd: %q", date)
+		return
+	}
+	if v.ClosedUntil.IsZero() {
+		return
+	}
+	if !d.After(v.ClosedUntil) {
+		errs.add(ErrMonthClosed, field, "the month is closed until %s: %s", v.ClosedUntil.Format(dateLayout), date)
+	}
+}
@@ -0,0 +1,166 @@
+package freee
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestJournalValidator(t *testing.T) {
+	t.Parallel()
+	v, err := NewJournalValidator(
+		[]AccountItem{
+			{ID: 100, Name: "現金", Available: true},
+			{ID: 200, Name: "売上高", Available: true},
+			{ID: 300, Name: "未使用", Available: false},
+		},
+		[]TaxCompany{{Code: 2, Available: true}, {Code: 129, Available: true}, {Code: 21, Available: false}},
+		"2021-05-31",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v.SetAccountItemDetails(
+		AccountItemDetail{AccountItem: AccountItem{ID: 200}, Items: &[]AccountItemLink{{ID: 1}}},
+		AccountItemDetail{AccountItem: AccountItem{ID: 100}},
+	)
+
+	journal := func(details ...CreateManualJournalParamsDetail) CreateManualJournalParams {
+		return CreateManualJournalParams{CompanyID: 1, IssueDate: "2021-06-01", CreateManualJournalParamsDetails: details}
+	}
+	debit := CreateManualJournalParamsDetail{EntrySide: ManualJournalEntrySideDebit, AccountItemID: 100, TaxCode: 2, Amount: 1000}
+	credit := CreateManualJournalParamsDetail{EntrySide: ManualJournalEntrySideCredit, AccountItemID: 200, TaxCode: 129, ItemID: 1, Amount: 1000}
+
+	tests := []struct {
+		name       string
+		validate   func() error
+		wantFields []string
+		wantKind   ErrorKind
+	}{
+		{
+			name:     "valid journal",
+			validate: func() error { return v.ValidateManualJournal(journal(debit, credit)) },
+		},
+		{
+			name: "unbalanced journal",
+			validate: func() error {
+				c := credit
+				c.Amount = 900
+				return v.ValidateManualJournal(journal(debit, c))
+			},
+			wantFields: []string{"details"},
+			wantKind:   ErrValidation,
+		},
+		{
+			name: "invalid lines",
+			validate: func() error {
+				d := debit
+				d.TaxCode = 0
+				c := credit
+				c.TaxCode = 21
+				c.ItemID = 2
+				u := CreateManualJournalParamsDetail{EntrySide: ManualJournalEntrySideDebit, AccountItemID: 300, TaxCode: 2, Amount: 0}
+				return v.ValidateManualJournal(journal(d, c, u))
+			},
+			wantFields: []string{"details[0].tax_code", "details[1].tax_code", "details[1].item_id", "details[2].amount", "details[2].account_item_id"},
+			wantKind:   ErrValidation,
+		},
+		{
+			name: "too many lines",
+			validate: func() error {
+				var details []CreateManualJournalParamsDetail
+				for i := 0; i < ManualJournalMaxDetails/2+1; i++ {
+					details = append(details, debit, credit)
+				}
+				return v.ValidateManualJournal(journal(details...))
+			},
+			wantFields: []string{"details"},
+			wantKind:   ErrValidation,
+		},
+		{
+			name: "closed month",
+			validate: func() error {
+				j := journal(debit, credit)
+				j.IssueDate = "2021-05-31"
+				return v.ValidateManualJournal(j)
+			},
+			wantFields: []string{"issue_date"},
+			wantKind:   ErrMonthClosed,
+		},
+		{
+			name: "too many deal lines",
+			validate: func() error {
+				details := make([]DealCreateParamsDetails, DealMaxDetails+1)
+				for i := range details {
+					details[i] = DealCreateParamsDetails{AccountItemID: 200, TaxCode: 129, Amount: 100}
+				}
+				return v.ValidateDeal(DealCreateParams{IssueDate: "2021-06-01", Type: DealTypeIncome, CompanyID: 1, Details: details})
+			},
+			wantFields: []string{"details"},
+			wantKind:   ErrValidation,
+		},
+		{
+			name: "valid deal",
+			validate: func() error {
+				return v.ValidateDeal(DealCreateParams{
+					IssueDate: "2021-06-01", Type: DealTypeIncome, CompanyID: 1,
+					Details:  []DealCreateParamsDetails{{AccountItemID: 200, TaxCode: 129, Amount: 1100}},
+					Payments: &[]DealCreateParamsPayments{{Amount: 1100, FromWalletableID: 1, FromWalletableType: "wallet", Date: "2021-06-10"}},
+				})
+			},
+		},
+		{
+			name: "invalid deal",
+			validate: func() error {
+				return v.ValidateDeal(DealCreateParams{
+					IssueDate: "2021/06/01", Type: "transfer", CompanyID: 1,
+					Details:  []DealCreateParamsDetails{{AccountItemID: 999, TaxCode: 129, Amount: 1100}},
+					Payments: &[]DealCreateParamsPayments{{Amount: 1200, FromWalletableID: 1, FromWalletableType: "wallet", Date: "2021-05-10"}},
+				})
+			},
+			wantFields: []string{"issue_date", "type", "details[0].account_item_id", "payments[0].date", "payments"},
+			wantKind:   ErrMonthClosed,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.validate()
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			var errs ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var fields []string
+			for _, fe := range errs {
+				fields = append(fields, fe.Field)
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Fatalf("unexpected fields: %v", fields)
+			}
+			if !errors.Is(err, tt.wantKind) || !errors.Is(err, ErrValidation) {
+				t.Fatalf("unexpected kinds: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewJournalValidatorClosedUntil(t *testing.T) {
+	t.Parallel()
+	if _, err := NewJournalValidator(nil, nil, "2021/05/31"); err == nil {
+		t.Fatal("malformed closed date is accepted")
+	}
+	v, err := NewJournalValidator(nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !v.ClosedUntil.IsZero() {
+		t.Fatalf("unexpected closed date: %v", v.ClosedUntil)
+	}
+}
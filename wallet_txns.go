@@ -50,17 +50,17 @@ type GetWalletTxnOpts struct {
 
 type WalletTxn struct {
 	// 明細ID
-	ID int32 `json:"id"`
+	ID int64 `json:"id"`
 	// 事業所ID
 	CompanyID int32 `json:"company_id"`
 	// 取引日（yyyy-mm-dd）
 	Date string `json:"date"`
 	// 取引金額
-	Amount int32 `json:"amount"`
+	Amount int64 `json:"amount"`
 	// 未決済金額
-	DueAmount int32 `json:"due_amount"`
+	DueAmount int64 `json:"due_amount"`
 	// 残高
-	Balance int32 `json:"balance"`
+	Balance int64 `json:"balance"`
 	// 入金/出勤（入金: income, 出勤: expense）
 	EntrySide string `json:"entry_side"`
 	// 口座区分 (銀行口座: bank_account, クレジットカード: credit_card, 現金: wallet)
@@ -75,6 +75,25 @@ type WalletTxn struct {
 	RuleMatched bool `json:"rule_matched"`
 }
 
+type CreateWalletTxnParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// 入金／出金 (入金: income, 出金: expense)
+	EntrySide string `json:"entry_side"`
+	// 取引金額
+	Amount int64 `json:"amount"`
+	// 取引日 (yyyy-mm-dd)
+	Date string `json:"date"`
+	// 口座区分 (銀行口座: bank_account, クレジットカード: credit_card, 現金: wallet)
+	WalletableType string `json:"walletable_type"`
+	// 口座ID
+	WalletableID int32 `json:"walletable_id"`
+	// 取引内容
+	Description *string `json:"description,omitempty"`
+	// 残高 (銀行口座等)
+	Balance *int64 `json:"balance,omitempty"`
+}
+
 func (c *Client) GetWalletTxns(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetWalletTxnOpts) (*WalletTxnsResponse, error) {
 	var result WalletTxnsResponse
 
@@ -96,6 +115,24 @@ func (c *Client) GetWalletTxns(ctx context.Context, reuseTokenSource oauth2.Toke
 	return &result, nil
 }
 
+func (c *Client) GetAllWalletTxns(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts GetWalletTxnOpts) ([]WalletTxn, error) {
+	var txns []WalletTxn
+
+	err := collectPages(ctx, opts.Offset, opts.Limit, MaxLimitWalletTxns, &txns, func(ctx context.Context, offset, limit int32) (interface{}, int32, error) {
+		opts.Offset, opts.Limit = offset, limit
+		result, err := c.GetWalletTxns(ctx, reuseTokenSource, companyID, opts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return result.WalletTxns, 0, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return txns, nil
+}
+
 func (c *Client) GetWalletTransaction(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, txnID int64, opts GetWalletTxnOpts) (*WalletTxn, error) {
 	var result WalletTxnResponse
 
@@ -117,6 +154,29 @@ func (c *Client) GetWalletTransaction(ctx context.Context, reuseTokenSource oaut
 	return &result.WalletTxn, nil
 }
 
+func (c *Client) CreateWalletTxn(ctx context.Context, reuseTokenSource oauth2.TokenSource, params CreateWalletTxnParams) (*WalletTxn, error) {
+	var result WalletTxnResponse
+	err := c.call(ctx, APIPathTxns, http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.WalletTxn, nil
+}
+
+func (c *Client) DestroyWalletTxn(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, txnID int64) error {
+	v, err := query.Values(nil)
+	if err != nil {
+		return err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathTxns, fmt.Sprint(txnID)), http.MethodDelete, reuseTokenSource, v, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (s *Client) GetWalletTxnOrderList() []string {
 	str := new(WalletTxn)
 
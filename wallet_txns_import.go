@@ -0,0 +1,85 @@
+package freee
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/oauth2"
+)
+
+// WalletTxnImportResult is the result of ImportWalletTxns.
+type WalletTxnImportResult struct {
+	// 作成した明細
+	Created []WalletTxn
+	// 既に登録済みのため作成しなかった明細
+	Skipped []CreateWalletTxnParams
+}
+
+// ImportWalletTxns creates the statement lines of a walletable which are not
+// registered yet, so it is safe to import the same statement again.
+// Lines are identified by date, entry side, amount and description. When a
+// statement has several identical lines, only the lines exceeding the number of
+// registered ones are created.
+// If an error occurs, the result contains the lines created until then.
+func (c *Client) ImportWalletTxns(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, walletableType string, walletableID int32, lines []CreateWalletTxnParams) (*WalletTxnImportResult, error) {
+	result := &WalletTxnImportResult{}
+	if len(lines) == 0 {
+		return result, nil
+	}
+
+	startDate, endDate := lines[0].Date, lines[0].Date
+	for _, line := range lines {
+		if line.Date == "" {
+			return result, fmt.Errorf("date of the wallet txn is required")
+		}
+		if line.Date < startDate {
+			startDate = line.Date
+		}
+		if line.Date > endDate {
+			endDate = line.Date
+		}
+	}
+
+	existing, err := c.GetAllWalletTxns(ctx, reuseTokenSource, companyID, GetWalletTxnOpts{
+		WalletableType: walletableType,
+		WalletableID:   walletableID,
+		StartDate:      startDate,
+		EndDate:        endDate,
+	})
+	if err != nil {
+		return result, err
+	}
+	registered := map[string]int{}
+	for _, txn := range existing {
+		registered[walletTxnKey(txn.Date, txn.EntrySide, txn.Amount, txn.Description)]++
+	}
+
+	for _, line := range lines {
+		line.CompanyID = companyID
+		line.WalletableType = walletableType
+		line.WalletableID = walletableID
+
+		var description string
+		if line.Description != nil {
+			description = *line.Description
+		}
+		key := walletTxnKey(line.Date, line.EntrySide, line.Amount, description)
+		if registered[key] > 0 {
+			registered[key]--
+			result.Skipped = append(result.Skipped, line)
+			continue
+		}
+
+		txn, err := c.CreateWalletTxn(ctx, reuseTokenSource, line)
+		if err != nil {
+			return result, err
+		}
+		result.Created = append(result.Created, *txn)
+	}
+	return result, nil
+}
+
+func walletTxnKey(date string, entrySide string, amount int64, description string) string {
+	return fmt.Sprintf("%s/%s/%d/%s", date, entrySide, amount, strings.TrimSpace(description))
+}
@@ -0,0 +1,60 @@
+package freee
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestImportWalletTxns(t *testing.T) {
+	t.Parallel()
+	var (
+		mu      sync.Mutex
+		created []CreateWalletTxnParams
+	)
+	client, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			q := r.URL.Query()
+			if q.Get("walletable_id") != "5" || q.Get("start_date") != "2021-06-01" || q.Get("end_date") != "2021-06-03" {
+				t.Errorf("unexpected query: %s", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"wallet_txns":[
+				{"id":1,"date":"2021-06-01","amount":500,"entry_side":"expense","description":"コーヒー"},
+				{"id":2,"date":"2021-06-02","amount":10000,"entry_side":"income","description":"振込 ｶ)ABC "}
+			]}`)
+		case http.MethodPost:
+			var params CreateWalletTxnParams
+			if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+				t.Error(err)
+			}
+			mu.Lock()
+			created = append(created, params)
+			id := len(created) + 10
+			mu.Unlock()
+			fmt.Fprintf(w, `{"wallet_txn":{"id":%d,"date":"%s","amount":%d}}`, id, params.Date, params.Amount)
+		}
+	})
+
+	description := func(s string) *string { return &s }
+	lines := []CreateWalletTxnParams{
+		{EntrySide: TxnsTypeExpense, Amount: 500, Date: "2021-06-01", Description: description("コーヒー")},
+		// 同日の同じ内容の明細は登録済みの件数を超えた分を作成する
+		{EntrySide: TxnsTypeExpense, Amount: 500, Date: "2021-06-01", Description: description("コーヒー")},
+		{EntrySide: TxnsTypeIncome, Amount: 10000, Date: "2021-06-02", Description: description("振込 ｶ)ABC")},
+		{EntrySide: TxnsTypeExpense, Amount: 3000, Date: "2021-06-03"},
+	}
+	result, err := client.ImportWalletTxns(context.Background(), ts, 1, WalletTypeBankAccount, 5, lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Created) != 2 || len(result.Skipped) != 2 {
+		t.Fatalf("unexpected result: %d created, %d skipped", len(result.Created), len(result.Skipped))
+	}
+	if created[0].Date != "2021-06-01" || created[1].Amount != 3000 || created[1].CompanyID != 1 || created[1].WalletableType != WalletTypeBankAccount || created[1].WalletableID != 5 {
+		t.Fatalf("unexpected created txns: %+v", created)
+	}
+}
@@ -45,10 +45,36 @@ type Walletable struct {
 	BankID int32 `json:"bank_id"`
 	// 口座区分 (銀行口座: bank_account, クレジットカード: credit_card, 現金: wallet)
 	Type string `json:"type"`
-	// 同期残高
-	LastBalance *int32 `json:"last_balance,omitempty"`
-	// 登録残高
-	WalletableBalance *int32 `json:"walletable_balance,omitempty"`
+	// 同期残高 (with_balance指定時のみ含まれる)
+	LastBalance *int64 `json:"last_balance,omitempty"`
+	// 登録残高 (with_balance指定時のみ含まれる)
+	WalletableBalance *int64 `json:"walletable_balance,omitempty"`
+}
+
+type CreateWalletableParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// 口座名 (255文字以内)
+	Name string `json:"name"`
+	// 口座種別（bank_account : 銀行口座, credit_card : クレジットカード, wallet : その他の決済口座）
+	Type string `json:"type"`
+	// サービスID
+	BankID *int32 `json:"bank_id,omitempty"`
+	// 口座を資産口座とするか負債口座とするか（true: 資産口座 (デフォルト), false: 負債口座）。bank_account, credit_cardの場合は無視されます
+	IsAsset *bool `json:"is_asset,omitempty"`
+	// 決算書表示名（小カテゴリー）。未指定の場合は口座種別ごとのデフォルトになります
+	GroupName *string `json:"group_name,omitempty"`
+}
+
+type UpdateWalletableParams struct {
+	// 事業所ID
+	CompanyID int32 `json:"company_id"`
+	// 口座名 (255文字以内)
+	Name string `json:"name"`
+	// サービスID
+	BankID *int32 `json:"bank_id,omitempty"`
+	// 決算書表示名（小カテゴリー）
+	GroupName *string `json:"group_name,omitempty"`
 }
 
 func (c *Client) GetWalletables(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, opts interface{}) (*WalletablesResponse, error) {
@@ -68,7 +94,7 @@ func (c *Client) GetWalletables(ctx context.Context, reuseTokenSource oauth2.Tok
 	return &result, nil
 }
 
-func (c *Client) GetWalletable(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, walletableID int32, opts interface{}) (*Walletable, error) {
+func (c *Client) GetWalletable(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, walletableType string, walletableID int32, opts interface{}) (*Walletable, error) {
 	var result WalletableResponse
 
 	v, err := query.Values(opts)
@@ -77,7 +103,7 @@ func (c *Client) GetWalletable(ctx context.Context, reuseTokenSource oauth2.Toke
 	}
 
 	SetCompanyID(&v, companyID)
-	err = c.call(ctx, path.Join(APIPathWalletables, fmt.Sprint(walletableID)), http.MethodGet, reuseTokenSource, v, nil, &result)
+	err = c.call(ctx, path.Join(APIPathWalletables, walletableType, fmt.Sprint(walletableID)), http.MethodGet, reuseTokenSource, v, nil, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +111,39 @@ func (c *Client) GetWalletable(ctx context.Context, reuseTokenSource oauth2.Toke
 	return &result.Walletable, nil
 }
 
+func (c *Client) CreateWalletable(ctx context.Context, reuseTokenSource oauth2.TokenSource, params CreateWalletableParams) (*Walletable, error) {
+	var result WalletableResponse
+	err := c.call(ctx, APIPathWalletables, http.MethodPost, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result.Walletable, nil
+}
+
+func (c *Client) UpdateWalletable(ctx context.Context, reuseTokenSource oauth2.TokenSource, walletableType string, walletableID int32, params UpdateWalletableParams) (*Walletable, error) {
+	// 口座の更新のレスポンスは walletable で包まれていません
+	var result Walletable
+	err := c.call(ctx, path.Join(APIPathWalletables, walletableType, fmt.Sprint(walletableID)), http.MethodPut, reuseTokenSource, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) DestroyWalletable(ctx context.Context, reuseTokenSource oauth2.TokenSource, companyID int32, walletableType string, walletableID int32) error {
+	v, err := query.Values(nil)
+	if err != nil {
+		return err
+	}
+	SetCompanyID(&v, companyID)
+	err = c.call(ctx, path.Join(APIPathWalletables, walletableType, fmt.Sprint(walletableID)), http.MethodDelete, reuseTokenSource, v, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (s *Client) GetWalletableOrderList() []string {
 	str := new(Walletable)
 
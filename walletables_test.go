@@ -0,0 +1,79 @@
+package freee
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestWalletableEndpoints(t *testing.T) {
+	t.Parallel()
+	walletable := `{"id":10,"name":"現金","bank_id":0,"type":"wallet"}`
+	check := func(got *Walletable) error {
+		if got.ID != 10 || got.Name != "現金" || got.Type != WalletTypeWallet {
+			return fmt.Errorf("unexpected walletable: %+v", got)
+		}
+		return nil
+	}
+
+	runEndpointTests(t, []endpointTest{
+		{
+			name: "get",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.GetWalletable(ctx, ts, 1, WalletTypeWallet, 10, GetWalletablesOpts{WithBalance: true})
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodGet, path: "/api/1/walletables/wallet/10", query: "company_id=1&with_balance=true",
+			response: `{"walletable":` + walletable + `,"meta":{"up_to_date":true}}`,
+		},
+		{
+			name: "create",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.CreateWalletable(ctx, ts, CreateWalletableParams{
+					CompanyID: 1,
+					Name:      "現金",
+					Type:      WalletTypeWallet,
+					IsAsset:   func(b bool) *bool { return &b }(true),
+				})
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodPost, path: "/api/1/walletables",
+			body:     `{"company_id":1,"name":"現金","type":"wallet","is_asset":true}`,
+			response: `{"walletable":` + walletable + `}`,
+		},
+		{
+			// 口座の更新のレスポンスは walletable で包まれていない
+			name: "update",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				got, err := c.UpdateWalletable(ctx, ts, WalletTypeWallet, 10, UpdateWalletableParams{
+					CompanyID: 1,
+					Name:      "現金",
+					GroupName: stringPtr("現金"),
+				})
+				if err != nil {
+					return err
+				}
+				return check(got)
+			},
+			method: http.MethodPut, path: "/api/1/walletables/wallet/10",
+			body:     `{"company_id":1,"name":"現金","group_name":"現金"}`,
+			response: walletable,
+		},
+		{
+			name: "destroy",
+			call: func(ctx context.Context, c *Client, ts oauth2.TokenSource) error {
+				return c.DestroyWalletable(ctx, ts, 1, WalletTypeWallet, 10)
+			},
+			method: http.MethodDelete, path: "/api/1/walletables/wallet/10", query: "company_id=1",
+		},
+	})
+}